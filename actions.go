@@ -22,8 +22,8 @@ type CancelAction struct {
 // CancelOrderWire has
 // See: https://github.com/hyperliquid-dex/hyperliquid-python-sdk/blob/f19056ca1b65cc15a019d92dffa9ada887b3d808/hyperliquid/exchange.py#L305-L310
 type CancelByCloidWire struct {
-	Asset    int    `json:"asset" msgpack:"asset"`
-	ClientID string `json:"cloid" msgpack:"cloid"`
+	Asset    int   `json:"asset" msgpack:"asset"`
+	ClientID Cloid `json:"cloid" msgpack:"cloid"`
 }
 
 // CancelByCloidAction represents the cancel by cloid action
@@ -98,9 +98,16 @@ type VaultDistributeAction struct {
 
 // UpdateLeverageAction represents leverage update
 type UpdateLeverageAction struct {
-	Type     string         `json:"type"     msgpack:"type"`
-	Asset    int            `json:"asset"    msgpack:"asset"`
-	Leverage map[string]any `json:"leverage" msgpack:"leverage"`
+	Type     string       `json:"type"     msgpack:"type"`
+	Asset    int          `json:"asset"    msgpack:"asset"`
+	Leverage LeverageWire `json:"leverage" msgpack:"leverage"`
+}
+
+// LeverageWire is the leverage of an UpdateLeverageAction. It is a struct
+// rather than a map so that it is sent in the order it is signed with.
+type LeverageWire struct {
+	Type  string `json:"type"  msgpack:"type"`
+	Value int    `json:"value" msgpack:"value"`
 }
 
 // UpdateIsolatedMarginAction represents isolated margin update
@@ -111,15 +118,35 @@ type UpdateIsolatedMarginAction struct {
 	Ntli  float64 `json:"ntli"  msgpack:"ntli"`
 }
 
+// LimitOrderTypeWire represents the limit order type wire format
+type LimitOrderTypeWire struct {
+	Tif string `json:"tif" msgpack:"tif"`
+}
+
+// TriggerOrderTypeWire represents the trigger order type wire format. The
+// field order matches the reference SDK and is part of the action hash.
+type TriggerOrderTypeWire struct {
+	IsMarket  bool   `json:"isMarket"  msgpack:"isMarket"`
+	TriggerPx string `json:"triggerPx" msgpack:"triggerPx"`
+	Tpsl      string `json:"tpsl"      msgpack:"tpsl"`
+}
+
+// OrderTypeWire represents the order type wire format. Exactly one of the
+// fields is set.
+type OrderTypeWire struct {
+	Limit   *LimitOrderTypeWire   `json:"limit,omitempty"   msgpack:"limit,omitempty"`
+	Trigger *TriggerOrderTypeWire `json:"trigger,omitempty" msgpack:"trigger,omitempty"`
+}
+
 // OrderWire represents the wire format for orders with deterministic field ordering
 type OrderWire struct {
-	Asset      int            `json:"a"           msgpack:"a"`
-	IsBuy      bool           `json:"b"           msgpack:"b"`
-	LimitPx    string         `json:"p"           msgpack:"p"`
-	Size       string         `json:"s"           msgpack:"s"`
-	ReduceOnly bool           `json:"r"           msgpack:"r"`
-	OrderType  map[string]any `json:"t"           msgpack:"t"`
-	Cloid      *string        `json:"c,omitempty" msgpack:"c,omitempty"`
+	Asset      int           `json:"a"           msgpack:"a"`
+	IsBuy      bool          `json:"b"           msgpack:"b"`
+	LimitPx    string        `json:"p"           msgpack:"p"`
+	Size       string        `json:"s"           msgpack:"s"`
+	ReduceOnly bool          `json:"r"           msgpack:"r"`
+	OrderType  OrderTypeWire `json:"t"           msgpack:"t"`
+	Cloid      *Cloid        `json:"c,omitempty" msgpack:"c,omitempty"`
 }
 
 // OrderAction represents the order action with deterministic field ordering
@@ -133,14 +160,44 @@ type OrderAction struct {
 // ModifyAction represents a single order modification
 type ModifyAction struct {
 	Type  string    `json:"type"  msgpack:"type"`
-	Oid   any       `json:"oid"   msgpack:"oid"`
+	Oid   OrderRef  `json:"oid"   msgpack:"oid"`
+	Order OrderWire `json:"order" msgpack:"order"`
+}
+
+// ModifyWire represents one entry of a batch modify. Unlike ModifyAction it
+// carries no type.
+type ModifyWire struct {
+	Oid   OrderRef  `json:"oid"   msgpack:"oid"`
 	Order OrderWire `json:"order" msgpack:"order"`
 }
 
 // BatchModifyAction represents multiple order modifications
 type BatchModifyAction struct {
-	Type     string         `json:"type"     msgpack:"type"`
-	Modifies []ModifyAction `json:"modifies" msgpack:"modifies"`
+	Type     string       `json:"type"     msgpack:"type"`
+	Modifies []ModifyWire `json:"modifies" msgpack:"modifies"`
+}
+
+// TwapWire represents the twap order wire format
+type TwapWire struct {
+	Asset      int    `json:"a" msgpack:"a"`
+	IsBuy      bool   `json:"b" msgpack:"b"`
+	Size       string `json:"s" msgpack:"s"`
+	ReduceOnly bool   `json:"r" msgpack:"r"`
+	Minutes    int    `json:"m" msgpack:"m"`
+	Randomize  bool   `json:"t" msgpack:"t"`
+}
+
+// TwapOrderAction represents the twap order action
+type TwapOrderAction struct {
+	Type string   `json:"type" msgpack:"type"`
+	Twap TwapWire `json:"twap" msgpack:"twap"`
+}
+
+// TwapCancelAction represents the twap cancel action
+type TwapCancelAction struct {
+	Type   string `json:"type" msgpack:"type"`
+	Asset  int    `json:"a"    msgpack:"a"`
+	TwapID int64  `json:"t"    msgpack:"t"`
 }
 
 // PerpDexClassTransferAction represents perp dex class transfer
@@ -225,10 +282,18 @@ type ConvertToMultiSigUserAction struct {
 	Nonce   int64  `json:"nonce"   msgpack:"nonce"`
 }
 
+// MultiSigPayload is the inner action of a multi-sig action, along with the
+// multi-sig user it is sent for and the signer that submits it
+type MultiSigPayload struct {
+	MultiSigUser string `json:"multiSigUser" msgpack:"multiSigUser"`
+	OuterSigner  string `json:"outerSigner"  msgpack:"outerSigner"`
+	Action       any    `json:"action"       msgpack:"action"`
+}
+
 // MultiSigAction represents multi-signature action
 type MultiSigAction struct {
-	Type       string         `json:"type"       msgpack:"type"`
-	Action     map[string]any `json:"action"     msgpack:"action"`
-	Signers    []string       `json:"signers"    msgpack:"signers"`
-	Signatures []string       `json:"signatures" msgpack:"signatures"`
+	Type             string            `json:"type"             msgpack:"type"`
+	SignatureChainID string            `json:"signatureChainId" msgpack:"signatureChainId"`
+	Signatures       []SignatureResult `json:"signatures"       msgpack:"signatures"`
+	Payload          MultiSigPayload   `json:"payload"          msgpack:"payload"`
 }
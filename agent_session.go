@@ -0,0 +1,249 @@
+package hyperliquid
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+)
+
+// DefaultAgentRotateBefore is how long before expiry an agent is rotated when
+// AgentSessionConfig.RotateBefore is zero
+const DefaultAgentRotateBefore = 24 * time.Hour
+
+// AgentSessionConfig configures an AgentSession
+type AgentSessionConfig struct {
+	// Name is the agent name. Approving an agent revokes the previous agent
+	// of the same name, so rotations keep it.
+	Name string
+	// KeystoreDir is the directory the encrypted agent keys are kept in
+	KeystoreDir string
+	// Passphrase encrypts the agent keys
+	Passphrase string
+	// ScryptN and ScryptP are the keystore scrypt parameters. Zero means
+	// keystore.StandardScryptN and keystore.StandardScryptP.
+	ScryptN int
+	ScryptP int
+	// ValidFor is how long agents are approved for. Zero leaves it to the API.
+	ValidFor time.Duration
+	// RotateBefore is how long before expiry the agent is replaced. Zero
+	// means DefaultAgentRotateBefore.
+	RotateBefore time.Duration
+	// VaultAddress makes the agent exchange trade for a vault of the master
+	// account instead of the master account itself
+	VaultAddress string
+}
+
+// AgentSession manages an API wallet of a master account: it approves the
+// agent with the master exchange, keeps its key in an encrypted keystore,
+// tracks how long it is valid for and rotates it before it expires.
+//
+// Get the agent-signed exchange with Exchange before each use: after a
+// rotation exchanges of the previous agent are rejected.
+type AgentSession struct {
+	master *Exchange
+	config AgentSessionConfig
+	store  *keystore.KeyStore
+	now    func() time.Time
+
+	mu         sync.Mutex
+	account    accounts.Account
+	agentKey   *ecdsa.PrivateKey
+	validUntil time.Time
+	exchange   *Exchange
+}
+
+// NewAgentSession opens the keystore and loads the most recent agent key in
+// it, if any. It does not contact the API.
+func NewAgentSession(master *Exchange, config AgentSessionConfig) (*AgentSession, error) {
+	if config.Name == "" {
+		return nil, errors.New("agent session needs a name")
+	}
+	if config.KeystoreDir == "" {
+		return nil, errors.New("agent session needs a keystore directory")
+	}
+	if config.ScryptN == 0 || config.ScryptP == 0 {
+		config.ScryptN, config.ScryptP = keystore.StandardScryptN, keystore.StandardScryptP
+	}
+	if config.RotateBefore == 0 {
+		config.RotateBefore = DefaultAgentRotateBefore
+	}
+
+	s := &AgentSession{
+		master: master,
+		config: config,
+		store:  keystore.NewKeyStore(config.KeystoreDir, config.ScryptN, config.ScryptP),
+		now:    time.Now,
+	}
+
+	// Key files are named after their creation time, newest last
+	if stored := s.store.Accounts(); len(stored) > 0 {
+		if err := s.load(stored[len(stored)-1]); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// AgentAddress returns the address of the current agent, or an empty string
+// if there is none yet
+func (s *AgentSession) AgentAddress() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.agentKey == nil {
+		return ""
+	}
+	return s.account.Address.Hex()
+}
+
+// ValidUntil returns when the current agent expires. It is zero until the
+// agent was approved or refreshed.
+func (s *AgentSession) ValidUntil() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.validUntil
+}
+
+// Exchange returns an exchange signed by the agent, approving a first agent
+// or rotating an expiring one as needed
+func (s *AgentSession) Exchange() (*Exchange, error) {
+	if _, err := s.RotateIfNeeded(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.exchange, nil
+}
+
+// Refresh reads the expiry of the current agent from the agents approved by
+// the master account. ValidUntil is zero if the agent is no longer approved.
+func (s *AgentSession) Refresh() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.refresh()
+}
+
+// RotateIfNeeded approves a new agent if there is none, it is no longer
+// approved, or it expires within RotateBefore. It reports whether it did.
+func (s *AgentSession) RotateIfNeeded() (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.agentKey != nil && s.validUntil.IsZero() {
+		if err := s.refresh(); err != nil {
+			return false, err
+		}
+	}
+	if s.agentKey != nil && s.now().Add(s.config.RotateBefore).Before(s.validUntil) {
+		return false, nil
+	}
+	return true, s.rotate()
+}
+
+// Rotate approves a new agent in place of the current one and deletes the
+// key of the previous agent
+func (s *AgentSession) Rotate() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rotate()
+}
+
+func (s *AgentSession) rotate() error {
+	account, err := s.store.NewAccount(s.config.Passphrase)
+	if err != nil {
+		return fmt.Errorf("failed to create agent key: %w", err)
+	}
+
+	name := s.config.Name
+	var validUntil time.Time
+	if s.config.ValidFor > 0 {
+		validUntil = s.now().Add(s.config.ValidFor)
+		name = fmt.Sprintf("%s valid_until %d", name, validUntil.UnixMilli())
+	}
+
+	resp, err := s.master.approveAgent(account.Address.Hex(), &name)
+	if err == nil && resp.Status != "ok" {
+		err = fmt.Errorf("agent approval failed: %s", approvalError(resp))
+	}
+	if err != nil {
+		_ = s.store.Delete(account, s.config.Passphrase)
+		return err
+	}
+
+	previous, hadPrevious := s.account, s.agentKey != nil
+	if err := s.load(account); err != nil {
+		return err
+	}
+	s.validUntil = validUntil
+	if validUntil.IsZero() {
+		if err := s.refresh(); err != nil {
+			return err
+		}
+	}
+
+	// The previous agent was revoked by the approval of the new one
+	if hadPrevious {
+		if err := s.store.Delete(previous, s.config.Passphrase); err != nil {
+			return fmt.Errorf("failed to delete previous agent key: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *AgentSession) refresh() error {
+	if s.agentKey == nil {
+		return nil
+	}
+
+	agents, err := s.master.info.ExtraAgents(s.master.accountAddress())
+	if err != nil {
+		return err
+	}
+
+	s.validUntil = time.Time{}
+	for _, agent := range agents {
+		if strings.EqualFold(agent.Address, s.account.Address.Hex()) {
+			s.validUntil = time.UnixMilli(agent.ValidUntil)
+			break
+		}
+	}
+	return nil
+}
+
+// load decrypts the key of account and makes it the current agent
+func (s *AgentSession) load(account accounts.Account) error {
+	data, err := os.ReadFile(account.URL.Path)
+	if err != nil {
+		return fmt.Errorf("failed to read agent key: %w", err)
+	}
+	key, err := keystore.DecryptKey(data, s.config.Passphrase)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt agent key: %w", err)
+	}
+
+	s.account = account
+	s.agentKey = key.PrivateKey
+	s.validUntil = time.Time{}
+	s.exchange = s.master.WithAgent(key.PrivateKey, s.config.VaultAddress)
+	return nil
+}
+
+// approvalError returns the error message of a rejected approval
+func approvalError(resp *AgentApprovalResponse) string {
+	var message string
+	if err := json.Unmarshal(resp.Response, &message); err == nil {
+		return message
+	}
+	if resp.Error != "" {
+		return resp.Error
+	}
+	return resp.Status
+}
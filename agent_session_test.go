@@ -0,0 +1,159 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// agentAPI answers approveAgent actions of master and extraAgents queries
+type agentAPI struct {
+	master string
+	agents []ExtraAgent
+	reject string
+}
+
+// exchange returns the master exchange and its transport
+func (a *agentAPI) exchange(t *testing.T) (*Exchange, *memoryTransport) {
+	transport := a.transport(t)
+	master := newMemoryExchange(t, transport)
+	a.master = crypto.PubkeyToAddress(master.privateKey.PublicKey).Hex()
+	return master, transport
+}
+
+func (a *agentAPI) transport(t *testing.T) *memoryTransport {
+	return &memoryTransport{
+		infoResponse: func(map[string]any) ([]byte, error) {
+			return json.Marshal(a.agents)
+		},
+		exchangeResponse: func(payload map[string]any) ([]byte, error) {
+			if a.reject != "" {
+				return json.Marshal(map[string]any{"status": "err", "response": a.reject})
+			}
+
+			// Approvals are user signed by the master, never for a vault
+			action := payload["action"].(map[string]any)
+			assert.NotContains(t, payload, "vaultAddress")
+			var sig SignatureResult
+			require.NoError(t, json.Unmarshal(mustMarshal(t, payload["signature"]), &sig))
+			signer, err := RecoverUserSignedActionSigner(action, sig)
+			require.NoError(t, err)
+			if signer != a.master {
+				return json.Marshal(map[string]any{
+					"status":   "err",
+					"response": "approval signed by " + signer,
+				})
+			}
+
+			name, _, _ := strings.Cut(action["agentName"].(string), " valid_until ")
+			a.agents = []ExtraAgent{{
+				Address:    action["agentAddress"].(string),
+				Name:       name,
+				ValidUntil: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC).UnixMilli(),
+			}}
+			return []byte(`{"status":"ok","response":{"type":"default"}}`), nil
+		},
+	}
+}
+
+func newAgentSession(t *testing.T, master *Exchange, config AgentSessionConfig) *AgentSession {
+	t.Helper()
+	config.Name = "bot"
+	config.Passphrase = "secret"
+	config.ScryptN, config.ScryptP = keystore.LightScryptN, keystore.LightScryptP
+	session, err := NewAgentSession(master, config)
+	require.NoError(t, err)
+	session.now = func() time.Time { return time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC) }
+	return session
+}
+
+func TestAgentSession(t *testing.T) {
+	api := &agentAPI{}
+	master, transport := api.exchange(t)
+	dir := t.TempDir()
+
+	session := newAgentSession(t, master, AgentSessionConfig{
+		KeystoreDir: dir,
+		ValidFor:    30 * 24 * time.Hour,
+	})
+	assert.Empty(t, session.AgentAddress())
+
+	agent, err := session.Exchange()
+	require.NoError(t, err)
+	require.Len(t, transport.exchangePayloads, 1)
+	assert.Contains(t, string(transport.exchangePayloads[0]), `"agentName":"bot valid_until 1769817600000"`)
+	assert.Equal(t, time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC), session.ValidUntil().UTC())
+
+	// The agent signs for the master account
+	assert.Equal(t, master.accountAddr, agent.accountAddr)
+	assert.Equal(t, session.AgentAddress(), crypto.PubkeyToAddress(agent.privateKey.PublicKey).Hex())
+	unsigned, err := agent.BuildAction(CancelAction{Type: "cancel"})
+	require.NoError(t, err)
+	signed, err := agent.SignAction(unsigned)
+	require.NoError(t, err)
+	assert.Equal(t, session.AgentAddress(), signed.Signer)
+
+	// A new session picks the persisted agent up and reads its expiry
+	reopened := newAgentSession(t, master, AgentSessionConfig{KeystoreDir: dir})
+	assert.Equal(t, session.AgentAddress(), reopened.AgentAddress())
+	_, err = reopened.Exchange()
+	require.NoError(t, err)
+	assert.Len(t, transport.exchangePayloads, 1)
+	assert.Equal(t, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), reopened.ValidUntil().UTC())
+
+	// Close to the expiry the agent is rotated and the old key deleted
+	previous := reopened.AgentAddress()
+	reopened.now = func() time.Time { return time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC) }
+	rotated, err := reopened.RotateIfNeeded()
+	require.NoError(t, err)
+	assert.True(t, rotated)
+	assert.NotEqual(t, previous, reopened.AgentAddress())
+	assert.Len(t, transport.exchangePayloads, 2)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Contains(t, strings.ToLower(files[0].Name()), strings.ToLower(reopened.AgentAddress()[2:]))
+}
+
+func TestAgentSession_Vault(t *testing.T) {
+	api := &agentAPI{}
+	master, transport := api.exchange(t)
+	vault := "0x1234567890123456789012345678901234567890"
+	master.vault = vault
+
+	session := newAgentSession(t, master, AgentSessionConfig{
+		KeystoreDir:  t.TempDir(),
+		VaultAddress: vault,
+	})
+	agent, err := session.Exchange()
+	require.NoError(t, err)
+	assert.Equal(t, vault, agent.vault)
+
+	unsigned, err := agent.BuildAction(CancelAction{Type: "cancel"})
+	require.NoError(t, err)
+	assert.Equal(t, vault, unsigned.VaultAddress)
+	require.Len(t, transport.exchangePayloads, 1)
+}
+
+func TestAgentSession_Rejected(t *testing.T) {
+	api := &agentAPI{reject: "Must deposit before performing actions."}
+	master, _ := api.exchange(t)
+	dir := t.TempDir()
+
+	session := newAgentSession(t, master, AgentSessionConfig{KeystoreDir: dir})
+	_, err := session.Exchange()
+	assert.EqualError(t, err, "agent approval failed: Must deposit before performing actions.")
+	assert.Empty(t, session.AgentAddress())
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
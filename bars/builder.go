@@ -0,0 +1,399 @@
+// Package bars builds OHLCV bars from the Hyperliquid trades stream: time bars
+// of any interval, volume bars and dollar bars. Bars are emitted as
+// hyperliquid.Candle so they can be used wherever server candles are.
+package bars
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	hl "github.com/sonirico/go-hyperliquid"
+)
+
+// Kind selects what closes a bar.
+type Kind int
+
+const (
+	// TimeBars close every Interval, aligned to the unix epoch like server candles.
+	TimeBars Kind = iota
+	// VolumeBars close once traded size reaches Threshold.
+	VolumeBars
+	// DollarBars close once traded notional reaches Threshold.
+	DollarBars
+)
+
+type Config struct {
+	Coin string
+	Kind Kind
+	// Interval is the bar length for TimeBars.
+	Interval time.Duration
+	// Threshold is the size (VolumeBars) or notional (DollarBars) per bar.
+	// The trade that crosses it is kept whole in the closing bar.
+	Threshold float64
+	// FillGaps emits flat, zero volume bars for intervals without trades.
+	// TimeBars only.
+	FillGaps bool
+	// AllowedLateness keeps bars open for trades that arrive out of order.
+	// Trades older than the newest trade time minus AllowedLateness that fall
+	// into an emitted bar are dropped and counted in Stats.Late.
+	AllowedLateness time.Duration
+}
+
+// Stats counts trades that were not applied.
+type Stats struct {
+	Duplicates int
+	Late       int
+}
+
+type bar struct {
+	start, end int64 // trade times, end exclusive for time bars
+	open       float64
+	high       float64
+	low        float64
+	close      float64
+	volume     float64
+	notional   float64
+	trades     int
+	openTime   int64
+	closeTime  int64
+}
+
+// Builder turns trades into bars. It is safe for concurrent use.
+type Builder struct {
+	cfg      Config
+	interval int64
+	label    string
+
+	mu sync.Mutex
+	// pending bars by start time; volume and dollar bars use a single entry
+	pending map[int64]*bar
+	// seen holds trade ids newer than cutoff
+	seen map[int64]int64
+	// watermark is the newest trade (or Advance) time seen
+	watermark int64
+	// cutoff is the time before which trades are late
+	cutoff    int64
+	lastClose float64
+	hasClose  bool
+	stats     Stats
+}
+
+func NewBuilder(cfg Config) (*Builder, error) {
+	if cfg.Coin == "" {
+		return nil, fmt.Errorf("bars: coin is required")
+	}
+	if cfg.AllowedLateness < 0 {
+		return nil, fmt.Errorf("bars: allowed lateness must not be negative")
+	}
+
+	b := &Builder{
+		cfg:     cfg,
+		pending: make(map[int64]*bar),
+		seen:    make(map[int64]int64),
+	}
+
+	switch cfg.Kind {
+	case TimeBars:
+		if cfg.Interval < time.Millisecond || cfg.Interval%time.Millisecond != 0 {
+			return nil, fmt.Errorf("bars: interval must be a positive number of milliseconds")
+		}
+		b.interval = cfg.Interval.Milliseconds()
+		b.label = FormatInterval(cfg.Interval)
+	case VolumeBars, DollarBars:
+		if cfg.Threshold <= 0 {
+			return nil, fmt.Errorf("bars: threshold must be positive")
+		}
+		if cfg.FillGaps {
+			return nil, fmt.Errorf("bars: gap filling requires time bars")
+		}
+		prefix := "vol"
+		if cfg.Kind == DollarBars {
+			prefix = "ntl"
+		}
+		b.label = prefix + formatNumber(cfg.Threshold)
+	default:
+		return nil, fmt.Errorf("bars: unknown kind %d", cfg.Kind)
+	}
+
+	return b, nil
+}
+
+// FormatInterval renders d the way candle intervals are named: "7s", "3m",
+// "2h", "1d". Durations that are not whole seconds use "ms".
+func FormatInterval(d time.Duration) string {
+	switch {
+	case d%(24*time.Hour) == 0:
+		return strconv.FormatInt(int64(d/(24*time.Hour)), 10) + "d"
+	case d%time.Hour == 0:
+		return strconv.FormatInt(int64(d/time.Hour), 10) + "h"
+	case d%time.Minute == 0:
+		return strconv.FormatInt(int64(d/time.Minute), 10) + "m"
+	case d%time.Second == 0:
+		return strconv.FormatInt(int64(d/time.Second), 10) + "s"
+	default:
+		return strconv.FormatInt(d.Milliseconds(), 10) + "ms"
+	}
+}
+
+// Add applies trades and returns the bars they complete, oldest first.
+// Trades for other coins are ignored. Trades with an unparsable price or size
+// are skipped and reported in the error, along with the bars of the others.
+func (b *Builder) Add(trades ...hl.Trade) ([]hl.Candle, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var (
+		out  []hl.Candle
+		errs []error
+	)
+	for _, trade := range trades {
+		if trade.Coin != b.cfg.Coin {
+			continue
+		}
+		px, err := strconv.ParseFloat(trade.Px, 64)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("bars: invalid trade price %q: %w", trade.Px, err))
+			continue
+		}
+		sz, err := strconv.ParseFloat(trade.Sz, 64)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("bars: invalid trade size %q: %w", trade.Sz, err))
+			continue
+		}
+
+		if trade.Time < b.cutoff {
+			b.stats.Late++
+			continue
+		}
+		if _, ok := b.seen[trade.Tid]; ok {
+			b.stats.Duplicates++
+			continue
+		}
+		b.seen[trade.Tid] = trade.Time
+
+		if b.cfg.Kind == TimeBars {
+			b.addTimed(trade.Time, px, sz)
+			out = append(out, b.closeUpTo(b.watermark-b.cfg.AllowedLateness.Milliseconds())...)
+		} else {
+			out = append(out, b.addThreshold(trade.Time, px, sz)...)
+		}
+	}
+	return out, errors.Join(errs...)
+}
+
+// Advance closes time bars whose interval ended before now, minus the allowed
+// lateness, even when no trade has arrived since. Call it from a ticker to
+// get bars (and gap fills) on time during quiet markets.
+func (b *Builder) Advance(now time.Time) []hl.Candle {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.cfg.Kind != TimeBars {
+		return nil
+	}
+	if ms := now.UnixMilli(); ms > b.watermark {
+		b.watermark = ms
+	}
+	return b.closeUpTo(b.watermark - b.cfg.AllowedLateness.Milliseconds())
+}
+
+// Current returns the newest open bar.
+func (b *Builder) Current() (hl.Candle, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var cur *bar
+	for _, p := range b.pending {
+		if cur == nil || p.start > cur.start {
+			cur = p
+		}
+	}
+	if cur == nil {
+		return hl.Candle{}, false
+	}
+	return b.candle(cur), true
+}
+
+// Flush closes and returns every open bar regardless of time.
+func (b *Builder) Flush() []hl.Candle {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	out := b.emit(b.sortedPending(func(*bar) bool { return true }))
+	b.setCutoff(max(b.cutoff, b.watermark+1))
+	return out
+}
+
+func (b *Builder) Stats() Stats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.stats
+}
+
+func (b *Builder) addTimed(t int64, px, sz float64) {
+	start := t - t%b.interval
+	p, ok := b.pending[start]
+	if !ok {
+		p = &bar{start: start, end: start + b.interval}
+		b.pending[start] = p
+	}
+	p.add(t, px, sz)
+	if t > b.watermark {
+		b.watermark = t
+	}
+}
+
+func (b *Builder) addThreshold(t int64, px, sz float64) []hl.Candle {
+	p, ok := b.pending[0]
+	if !ok {
+		p = &bar{start: t, end: t}
+		b.pending[0] = p
+	}
+	p.add(t, px, sz)
+	if t > b.watermark {
+		b.watermark = t
+	}
+
+	filled := p.volume
+	if b.cfg.Kind == DollarBars {
+		filled = p.notional
+	}
+	if filled < b.cfg.Threshold {
+		return nil
+	}
+
+	delete(b.pending, 0)
+	out := b.emit([]*bar{p})
+	if cutoff := b.watermark - b.cfg.AllowedLateness.Milliseconds(); cutoff > b.cutoff {
+		b.setCutoff(cutoff)
+	}
+	return out
+}
+
+// closeUpTo emits time bars that end at or before boundary, filling gaps.
+func (b *Builder) closeUpTo(boundary int64) []hl.Candle {
+	boundary -= boundary % b.interval
+	if boundary <= b.cutoff {
+		return nil
+	}
+
+	out := b.emit(b.sortedPending(func(p *bar) bool { return p.end <= boundary }))
+	if b.cfg.FillGaps && b.hasClose {
+		out = append(out, b.fill(boundary)...)
+	}
+	b.setCutoff(boundary)
+	return out
+}
+
+func (b *Builder) sortedPending(match func(*bar) bool) []*bar {
+	var closed []*bar
+	for start, p := range b.pending {
+		if match(p) {
+			closed = append(closed, p)
+			delete(b.pending, start)
+		}
+	}
+	sort.Slice(closed, func(i, j int) bool { return closed[i].start < closed[j].start })
+	return closed
+}
+
+func (b *Builder) emit(closed []*bar) []hl.Candle {
+	out := make([]hl.Candle, 0, len(closed))
+	for _, p := range closed {
+		if b.cfg.Kind == TimeBars && b.cfg.FillGaps && b.hasClose {
+			out = append(out, b.fill(p.start)...)
+		}
+		out = append(out, b.candle(p))
+		b.lastClose, b.hasClose = p.close, true
+		if b.cfg.Kind == TimeBars && p.end > b.cutoff {
+			b.cutoff = p.end
+		}
+	}
+	return out
+}
+
+// fill emits flat bars from the cutoff up to (excluding) until.
+func (b *Builder) fill(until int64) []hl.Candle {
+	var out []hl.Candle
+	for start := b.cutoff; start < until; start += b.interval {
+		if _, ok := b.pending[start]; ok {
+			continue
+		}
+		out = append(out, b.candle(&bar{
+			start: start,
+			end:   start + b.interval,
+			open:  b.lastClose,
+			high:  b.lastClose,
+			low:   b.lastClose,
+			close: b.lastClose,
+		}))
+	}
+	if until > b.cutoff {
+		b.cutoff = until
+	}
+	return out
+}
+
+// setCutoff moves the late boundary forward and forgets trade ids before it.
+func (b *Builder) setCutoff(cutoff int64) {
+	b.cutoff = cutoff
+	for tid, t := range b.seen {
+		if t < cutoff {
+			delete(b.seen, tid)
+		}
+	}
+}
+
+func (b *Builder) candle(p *bar) hl.Candle {
+	closeTime := p.end - 1
+	if b.cfg.Kind != TimeBars {
+		closeTime = p.end
+	}
+	return hl.Candle{
+		Time:      p.start,
+		Timestamp: closeTime,
+		Symbol:    b.cfg.Coin,
+		Interval:  b.label,
+		Open:      formatNumber(p.open),
+		High:      formatNumber(p.high),
+		Low:       formatNumber(p.low),
+		Close:     formatNumber(p.close),
+		Volume:    formatNumber(p.volume),
+		Number:    p.trades,
+	}
+}
+
+func (p *bar) add(t int64, px, sz float64) {
+	if p.trades == 0 {
+		p.open, p.high, p.low, p.close = px, px, px, px
+		p.openTime, p.closeTime = t, t
+	} else {
+		// Trades may arrive out of order: open and close follow trade time.
+		if t < p.openTime {
+			p.open, p.openTime = px, t
+		}
+		if t >= p.closeTime {
+			p.close, p.closeTime = px, t
+		}
+		p.high = max(p.high, px)
+		p.low = min(p.low, px)
+	}
+	p.volume += sz
+	p.notional += px * sz
+	p.trades++
+	if p.end < t {
+		// volume and dollar bars span from the first to the last trade
+		p.end = t
+	}
+	if p.start > t {
+		p.start = t
+	}
+}
+
+func formatNumber(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,261 @@
+package bars
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	hl "github.com/sonirico/go-hyperliquid"
+)
+
+const t0 = int64(1_699_999_560_000) // aligned to 7s, 1m and 3m
+
+func trade(tid, t int64, px, sz string) hl.Trade {
+	return hl.Trade{Coin: "BTC", Tid: tid, Time: t, Px: px, Sz: sz, Side: "B"}
+}
+
+func ohlcv(c hl.Candle) []string {
+	return []string{c.Open, c.High, c.Low, c.Close, c.Volume}
+}
+
+func TestFormatInterval(t *testing.T) {
+	assert.Equal(t, "7s", FormatInterval(7*time.Second))
+	assert.Equal(t, "3m", FormatInterval(3*time.Minute))
+	assert.Equal(t, "2h", FormatInterval(2*time.Hour))
+	assert.Equal(t, "1d", FormatInterval(24*time.Hour))
+	assert.Equal(t, "90s", FormatInterval(90*time.Second))
+	assert.Equal(t, "250ms", FormatInterval(250*time.Millisecond))
+}
+
+func TestNewBuilder_Invalid(t *testing.T) {
+	tests := []Config{
+		{Kind: TimeBars, Interval: time.Second},
+		{Coin: "BTC", Kind: TimeBars},
+		{Coin: "BTC", Kind: VolumeBars},
+		{Coin: "BTC", Kind: DollarBars, Threshold: 1, FillGaps: true},
+		{Coin: "BTC", Kind: Kind(9)},
+	}
+	for _, cfg := range tests {
+		_, err := NewBuilder(cfg)
+		assert.Error(t, err, "%+v", cfg)
+	}
+}
+
+func TestBuilder_TimeBars(t *testing.T) {
+	b, err := NewBuilder(Config{Coin: "BTC", Kind: TimeBars, Interval: 7 * time.Second})
+	require.NoError(t, err)
+
+	out, err := b.Add(
+		trade(1, t0+1000, "100", "1"),
+		trade(2, t0+3000, "105", "2"),
+		trade(3, t0+2000, "95", "1"),
+		trade(4, t0+6999, "101", "0.5"),
+		trade(2, t0+3000, "105", "2"), // duplicate
+		hl.Trade{Coin: "ETH", Tid: 9, Time: t0, Px: "1", Sz: "1"},
+	)
+	require.NoError(t, err)
+	assert.Empty(t, out)
+
+	cur, ok := b.Current()
+	require.True(t, ok)
+	assert.Equal(t, []string{"100", "105", "95", "101", "4.5"}, ohlcv(cur))
+
+	out, err = b.Add(trade(5, t0+7000, "110", "1"))
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+	assert.Equal(t, hl.Candle{
+		Time:      t0,
+		Timestamp: t0 + 6999,
+		Symbol:    "BTC",
+		Interval:  "7s",
+		Open:      "100",
+		High:      "105",
+		Low:       "95",
+		Close:     "101",
+		Volume:    "4.5",
+		Number:    4,
+	}, out[0])
+
+	// A trade for the emitted bar is late.
+	out, err = b.Add(trade(6, t0+6000, "90", "1"))
+	require.NoError(t, err)
+	assert.Empty(t, out)
+	assert.Equal(t, Stats{Duplicates: 1, Late: 1}, b.Stats())
+
+	out = b.Flush()
+	require.Len(t, out, 1)
+	assert.Equal(t, []string{"110", "110", "110", "110", "1"}, ohlcv(out[0]))
+}
+
+func TestBuilder_InvalidTrade(t *testing.T) {
+	b, err := NewBuilder(Config{Coin: "BTC", Kind: TimeBars, Interval: 7 * time.Second})
+	require.NoError(t, err)
+
+	// The trades after the bad ones are still applied
+	out, err := b.Add(
+		trade(1, t0+1000, "100", "1"),
+		trade(2, t0+2000, "bad", "1"),
+		trade(3, t0+3000, "101", "x"),
+		trade(4, t0+7000, "102", "1"),
+	)
+	assert.EqualError(t, err, "bars: invalid trade price \"bad\": strconv.ParseFloat: parsing \"bad\": invalid syntax\n"+
+		"bars: invalid trade size \"x\": strconv.ParseFloat: parsing \"x\": invalid syntax")
+	require.Len(t, out, 1)
+	assert.Equal(t, []string{"100", "100", "100", "100", "1"}, ohlcv(out[0]))
+
+	cur, ok := b.Current()
+	require.True(t, ok)
+	assert.Equal(t, []string{"102", "102", "102", "102", "1"}, ohlcv(cur))
+}
+
+func TestBuilder_AllowedLateness(t *testing.T) {
+	b, err := NewBuilder(Config{
+		Coin:            "BTC",
+		Kind:            TimeBars,
+		Interval:        time.Minute,
+		AllowedLateness: 2 * time.Second,
+	})
+	require.NoError(t, err)
+
+	out, err := b.Add(
+		trade(1, t0+10_000, "100", "1"),
+		trade(2, t0+60_500, "101", "1"),
+		trade(3, t0+59_000, "99", "1"), // late but within lateness
+	)
+	require.NoError(t, err)
+	assert.Empty(t, out)
+
+	out, err = b.Add(trade(4, t0+62_000, "102", "1"))
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+	assert.Equal(t, []string{"100", "100", "99", "99", "2"}, ohlcv(out[0]))
+
+	_, err = b.Add(trade(5, t0+59_500, "98", "1"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, b.Stats().Late)
+}
+
+func TestBuilder_FillGaps(t *testing.T) {
+	b, err := NewBuilder(Config{
+		Coin:     "BTC",
+		Kind:     TimeBars,
+		Interval: time.Minute,
+		FillGaps: true,
+	})
+	require.NoError(t, err)
+
+	_, err = b.Add(trade(1, t0+1000, "100", "1"))
+	require.NoError(t, err)
+
+	out, err := b.Add(trade(2, t0+3*60_000+5, "104", "1"))
+	require.NoError(t, err)
+	require.Len(t, out, 3)
+	assert.Equal(t, []int64{t0, t0 + 60_000, t0 + 120_000}, []int64{out[0].Time, out[1].Time, out[2].Time})
+	assert.Equal(t, []string{"100", "100", "100", "100", "0"}, ohlcv(out[1]))
+	assert.Equal(t, 0, out[2].Number)
+
+	// Advance closes bars during quiet periods.
+	out = b.Advance(time.UnixMilli(t0 + 5*60_000 + 1))
+	require.Len(t, out, 2)
+	assert.Equal(t, []string{"104", "104", "104", "104", "1"}, ohlcv(out[0]))
+	assert.Equal(t, []string{"104", "104", "104", "104", "0"}, ohlcv(out[1]))
+	assert.Equal(t, t0+4*60_000, out[1].Time)
+}
+
+func TestBuilder_ThresholdBars(t *testing.T) {
+	t.Run("volume", func(t *testing.T) {
+		b, err := NewBuilder(Config{Coin: "BTC", Kind: VolumeBars, Threshold: 3})
+		require.NoError(t, err)
+
+		out, err := b.Add(
+			trade(1, t0, "100", "1"),
+			trade(2, t0+10, "101", "1.5"),
+			trade(3, t0+20, "99", "1"),
+			trade(4, t0+30, "102", "2"),
+			trade(3, t0+20, "99", "1"),
+		)
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+		assert.Equal(t, "vol3", out[0].Interval)
+		assert.Equal(t, []string{"100", "101", "99", "99", "3.5"}, ohlcv(out[0]))
+		assert.Equal(t, t0, out[0].Time)
+		assert.Equal(t, t0+20, out[0].Timestamp)
+		assert.Equal(t, 1, b.Stats().Duplicates)
+
+		cur, ok := b.Current()
+		require.True(t, ok)
+		assert.Equal(t, "2", cur.Volume)
+	})
+
+	t.Run("dollar", func(t *testing.T) {
+		b, err := NewBuilder(Config{Coin: "BTC", Kind: DollarBars, Threshold: 250})
+		require.NoError(t, err)
+
+		out, err := b.Add(
+			trade(1, t0, "100", "1"),
+			trade(2, t0+10, "100", "1"),
+			trade(3, t0+20, "50", "1"),
+			trade(4, t0+5, "100", "1"), // older than the emitted bar
+		)
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+		assert.Equal(t, "ntl250", out[0].Interval)
+		assert.Equal(t, []string{"100", "100", "50", "50", "3"}, ohlcv(out[0]))
+		assert.Equal(t, 1, b.Stats().Late)
+	})
+}
+
+func TestBuilder_Seed(t *testing.T) {
+	candle := func(i int64, o, h, l, c, v string) hl.Candle {
+		return hl.Candle{
+			Time:      t0 + i*60_000,
+			Timestamp: t0 + (i+1)*60_000 - 1,
+			Symbol:    "BTC",
+			Interval:  "1m",
+			Open:      o,
+			High:      h,
+			Low:       l,
+			Close:     c,
+			Volume:    v,
+			Number:    1,
+		}
+	}
+
+	b, err := NewBuilder(Config{Coin: "BTC", Kind: TimeBars, Interval: 3 * time.Minute})
+	require.NoError(t, err)
+
+	out, err := b.Seed([]hl.Candle{
+		candle(3, "103", "106", "102", "104", "1"),
+		candle(0, "100", "101", "99", "100", "1"),
+		candle(1, "100", "102", "98", "101", "2"),
+		candle(2, "101", "103", "100", "103", "3"),
+	})
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+	assert.Equal(t, "3m", out[0].Interval)
+	assert.Equal(t, []string{"100", "103", "98", "103", "6"}, ohlcv(out[0]))
+	assert.Equal(t, 3, out[0].Number)
+
+	// Trades already covered by the candles are dropped.
+	_, err = b.Add(trade(1, t0+3*60_000+10, "150", "1"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, b.Stats().Late)
+
+	// Live trades continue the partially seeded bar.
+	_, err = b.Add(trade(2, t0+4*60_000+10, "107", "1"))
+	require.NoError(t, err)
+	out, err = b.Add(trade(3, t0+6*60_000, "108", "1"))
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+	assert.Equal(t, []string{"103", "107", "102", "107", "2"}, ohlcv(out[0]))
+
+	_, err = b.Seed([]hl.Candle{candle(0, "1", "1", "1", "1", "1")})
+	assert.ErrorContains(t, err, "already has data")
+
+	b2, err := NewBuilder(Config{Coin: "BTC", Kind: TimeBars, Interval: 90 * time.Second})
+	require.NoError(t, err)
+	_, err = b2.Seed([]hl.Candle{candle(0, "1", "1", "1", "1", "1")})
+	assert.ErrorContains(t, err, "does not divide")
+}
@@ -0,0 +1,116 @@
+package bars
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	hl "github.com/sonirico/go-hyperliquid"
+)
+
+// Seed primes a time bar builder with server candles, e.g. from
+// Info.CandlesSnapshot, so that history and live trades line up. The candle
+// interval must divide the builder interval: 1m candles can seed 3m bars, 1h
+// candles 2h bars. Only complete candles should be passed; trades up to the
+// end of the last candle are treated as already counted and dropped as late.
+//
+// Seed returns the bars fully covered by the candles. A partially covered bar
+// stays open and continues with live trades.
+func (b *Builder) Seed(candles []hl.Candle) ([]hl.Candle, error) {
+	if b.cfg.Kind != TimeBars {
+		return nil, fmt.Errorf("bars: seeding requires time bars")
+	}
+	if len(candles) == 0 {
+		return nil, nil
+	}
+
+	candles = append([]hl.Candle(nil), candles...)
+	sort.Slice(candles, func(i, j int) bool { return candles[i].Time < candles[j].Time })
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.pending) > 0 || b.hasClose {
+		return nil, fmt.Errorf("bars: builder already has data")
+	}
+
+	for _, c := range candles {
+		if c.Symbol != "" && c.Symbol != b.cfg.Coin {
+			return nil, fmt.Errorf("bars: candle for %s, builder is %s", c.Symbol, b.cfg.Coin)
+		}
+		dur := c.Timestamp - c.Time + 1
+		if dur <= 0 || b.interval%dur != 0 || c.Time%dur != 0 {
+			return nil, fmt.Errorf(
+				"bars: candle interval %q does not divide %s", c.Interval, b.label)
+		}
+
+		p, err := candleBar(c)
+		if err != nil {
+			return nil, err
+		}
+
+		start := c.Time - c.Time%b.interval
+		cur, ok := b.pending[start]
+		if !ok {
+			cur = &bar{start: start, end: start + b.interval}
+			b.pending[start] = cur
+		}
+		cur.merge(p)
+
+		if end := c.Timestamp + 1; end > b.watermark {
+			b.watermark = end
+		}
+	}
+
+	out := b.emit(b.sortedPending(func(p *bar) bool { return p.end <= b.watermark }))
+	if b.cfg.FillGaps && b.hasClose {
+		// gaps between the last complete bar and the open one
+		out = append(out, b.fill(b.watermark-b.watermark%b.interval)...)
+	}
+	b.setCutoff(b.watermark)
+	return out, nil
+}
+
+func candleBar(c hl.Candle) (*bar, error) {
+	fields := []string{c.Open, c.High, c.Low, c.Close, c.Volume}
+	values := make([]float64, len(fields))
+	for i, f := range fields {
+		v, err := strconv.ParseFloat(f, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bars: invalid candle value %q: %w", f, err)
+		}
+		values[i] = v
+	}
+	return &bar{
+		start:     c.Time,
+		end:       c.Timestamp + 1,
+		open:      values[0],
+		high:      values[1],
+		low:       values[2],
+		close:     values[3],
+		volume:    values[4],
+		trades:    c.Number,
+		openTime:  c.Time,
+		closeTime: c.Timestamp,
+	}, nil
+}
+
+// merge folds a later (or earlier) sub-bar into p.
+func (p *bar) merge(o *bar) {
+	if p.openTime == 0 && p.closeTime == 0 {
+		p.open, p.high, p.low, p.close = o.open, o.high, o.low, o.close
+		p.openTime, p.closeTime = o.openTime, o.closeTime
+	} else {
+		if o.openTime < p.openTime {
+			p.open, p.openTime = o.open, o.openTime
+		}
+		if o.closeTime >= p.closeTime {
+			p.close, p.closeTime = o.close, o.closeTime
+		}
+		p.high = max(p.high, o.high)
+		p.low = min(p.low, o.low)
+	}
+	p.volume += o.volume
+	p.notional += o.notional
+	p.trades += o.trades
+}
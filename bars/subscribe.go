@@ -0,0 +1,26 @@
+package bars
+
+import (
+	hl "github.com/sonirico/go-hyperliquid"
+)
+
+// Subscribe feeds the builder from ws.Trades and calls onBar for every
+// completed bar, or with an error from the stream.
+func (b *Builder) Subscribe(
+	ws *hl.WebsocketClient,
+	onBar func(hl.Candle, error),
+) (*hl.Subscription, error) {
+	return ws.Trades(hl.TradesSubscriptionParams{Coin: b.cfg.Coin}, func(trades []hl.Trade, err error) {
+		if err != nil {
+			onBar(hl.Candle{}, err)
+			return
+		}
+		candles, err := b.Add(trades...)
+		for _, c := range candles {
+			onBar(c, nil)
+		}
+		if err != nil {
+			onBar(hl.Candle{}, err)
+		}
+	})
+}
@@ -38,6 +38,10 @@ func NewClient(baseURL string) *Client {
 }
 
 func (c *Client) post(path string, payload any) ([]byte, error) {
+	return c.postContext(context.Background(), path, payload)
+}
+
+func (c *Client) postContext(ctx context.Context, path string, payload any) ([]byte, error) {
 	jsonData, err := json.Marshal(payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal payload: %w", err)
@@ -45,7 +49,7 @@ func (c *Client) post(path string, payload any) ([]byte, error) {
 
 	url := c.baseURL + path
 	req, err := http.NewRequestWithContext(
-		context.Background(),
+		ctx,
 		"POST",
 		url,
 		bytes.NewBuffer(jsonData),
@@ -0,0 +1,135 @@
+package hyperliquid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Cloid is a client order id: 16 bytes written as 0x-prefixed hex, e.g.
+// "0x00000000000000000000000000000001". It encodes to that string in JSON and
+// msgpack.
+type Cloid [16]byte
+
+// NewCloid returns a random Cloid
+func NewCloid() Cloid {
+	var c Cloid
+	if _, err := rand.Read(c[:]); err != nil {
+		panic(fmt.Sprintf("failed to generate cloid: %v", err))
+	}
+	return c
+}
+
+// CloidFor returns a deterministic Cloid whose first 8 bytes are strategyID
+// and last 8 bytes are seq, both big endian. See Cloid.Strategy.
+func CloidFor(strategyID, seq uint64) Cloid {
+	var c Cloid
+	binary.BigEndian.PutUint64(c[:8], strategyID)
+	binary.BigEndian.PutUint64(c[8:], seq)
+	return c
+}
+
+// ParseCloid parses a 0x-prefixed, 32 digit hex string
+func ParseCloid(s string) (Cloid, error) {
+	var c Cloid
+	raw, ok := strings.CutPrefix(s, "0x")
+	if !ok {
+		return c, fmt.Errorf("invalid cloid %q: missing 0x prefix", s)
+	}
+	if len(raw) != 2*len(c) {
+		return c, fmt.Errorf("invalid cloid %q: want %d hex digits, got %d", s, 2*len(c), len(raw))
+	}
+	if _, err := hex.Decode(c[:], []byte(raw)); err != nil {
+		return c, fmt.Errorf("invalid cloid %q: %w", s, err)
+	}
+	return c, nil
+}
+
+// MustParseCloid is like ParseCloid but panics on invalid input
+func MustParseCloid(s string) Cloid {
+	c, err := ParseCloid(s)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// String returns the 0x-prefixed lowercase hex form
+func (c Cloid) String() string {
+	return "0x" + hex.EncodeToString(c[:])
+}
+
+// ToRaw returns the wire form of the cloid. It is the same as String.
+func (c Cloid) ToRaw() string {
+	return c.String()
+}
+
+// IsZero reports whether c is the all-zero cloid
+func (c Cloid) IsZero() bool {
+	return c == Cloid{}
+}
+
+// Strategy splits a Cloid built with CloidFor back into its parts
+func (c Cloid) Strategy() (strategyID, seq uint64) {
+	return binary.BigEndian.Uint64(c[:8]), binary.BigEndian.Uint64(c[8:])
+}
+
+func (c Cloid) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+func (c *Cloid) UnmarshalText(text []byte) error {
+	parsed, err := ParseCloid(string(text))
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+func (c Cloid) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + c.String() + `"`), nil
+}
+
+func (c *Cloid) UnmarshalJSON(data []byte) error {
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return fmt.Errorf("invalid cloid %s: expected a string", data)
+	}
+	return c.UnmarshalText(data[1 : len(data)-1])
+}
+
+func (c Cloid) EncodeMsgpack(enc *msgpack.Encoder) error {
+	return enc.EncodeString(c.String())
+}
+
+func (c *Cloid) DecodeMsgpack(dec *msgpack.Decoder) error {
+	s, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+	return c.UnmarshalText([]byte(s))
+}
+
+// CloidGenerator hands out deterministic Cloids for one strategy. It is safe
+// for concurrent use.
+type CloidGenerator struct {
+	strategyID uint64
+	seq        atomic.Uint64
+}
+
+// NewCloidGenerator returns a generator whose first Cloid has sequence start
+func NewCloidGenerator(strategyID, start uint64) *CloidGenerator {
+	g := &CloidGenerator{strategyID: strategyID}
+	g.seq.Store(start)
+	return g
+}
+
+// Next returns the next Cloid of the strategy
+func (g *CloidGenerator) Next() Cloid {
+	return CloidFor(g.strategyID, g.seq.Add(1)-1)
+}
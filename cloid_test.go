@@ -0,0 +1,96 @@
+package hyperliquid
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func cloidPtr(s string) *Cloid {
+	c := MustParseCloid(s)
+	return &c
+}
+
+func TestParseCloid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{name: "valid", input: "0x285ad26a251f390c83d065af51e3f8d9"},
+		{name: "uppercase hex", input: "0x285AD26A251F390C83D065AF51E3F8D9"},
+		{name: "missing prefix", input: "285ad26a251f390c83d065af51e3f8d9", wantErr: "missing 0x prefix"},
+		{name: "too short", input: "0x285ad26a", wantErr: "want 32 hex digits, got 8"},
+		{name: "too long", input: "0x285ad26a251f390c83d065af51e3f8d9aa", wantErr: "want 32 hex digits, got 34"},
+		{name: "not hex", input: "0xzz5ad26a251f390c83d065af51e3f8d9", wantErr: "invalid byte"},
+		{name: "free text", input: "modified_order_123", wantErr: "missing 0x prefix"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := ParseCloid(tc.input)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "0x285ad26a251f390c83d065af51e3f8d9", c.String())
+		})
+	}
+}
+
+func TestCloidFor(t *testing.T) {
+	c := CloidFor(0x06c6, 0x3f5a)
+	assert.Equal(t, "0x00000000000006c60000000000003f5a", c.String())
+
+	strategyID, seq := c.Strategy()
+	assert.Equal(t, uint64(0x06c6), strategyID)
+	assert.Equal(t, uint64(0x3f5a), seq)
+
+	gen := NewCloidGenerator(7, 41)
+	assert.Equal(t, CloidFor(7, 41), gen.Next())
+	assert.Equal(t, CloidFor(7, 42), gen.Next())
+}
+
+func TestNewCloid(t *testing.T) {
+	a, b := NewCloid(), NewCloid()
+	assert.NotEqual(t, a, b)
+	assert.False(t, a.IsZero())
+	assert.True(t, Cloid{}.IsZero())
+
+	parsed, err := ParseCloid(a.String())
+	require.NoError(t, err)
+	assert.Equal(t, a, parsed)
+}
+
+func TestCloid_Encoding(t *testing.T) {
+	c := MustParseCloid("0x00000000000000000000000000000001")
+
+	data, err := json.Marshal(struct {
+		Cloid *Cloid `json:"cloid"`
+	}{&c})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"cloid":"0x00000000000000000000000000000001"}`, string(data))
+
+	var decoded struct {
+		Cloid *Cloid `json:"cloid"`
+	}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, &c, decoded.Cloid)
+	assert.Error(t, json.Unmarshal([]byte(`{"cloid":"0x01"}`), &decoded))
+	assert.Error(t, json.Unmarshal([]byte(`{"cloid":1}`), &decoded))
+
+	// The wire form is the same msgpack string the reference SDK packs
+	packed, err := packAction(c)
+	require.NoError(t, err)
+	assert.Equal(t, "d922"+hex.EncodeToString([]byte(c.String())), hex.EncodeToString(packed))
+
+	var unpacked Cloid
+	require.NoError(t, msgpack.Unmarshal(packed, &unpacked))
+	assert.Equal(t, c, unpacked)
+}
@@ -0,0 +1,143 @@
+// Command hlsign signs actions on an offline machine and submits them from
+// another one.
+//
+// An unsigned action file holds the action, nonce, vault, expiresAfter and
+// network, as written by UnsignedAction.WriteFile or by hand:
+//
+//	{
+//	  "action": {"type": "withdraw3", "destination": "0x...", "amount": "100.000000", "time": 1700000000000},
+//	  "nonce": 1700000000000,
+//	  "isMainnet": true
+//	}
+//
+// Transfers and withdrawals get an EIP-712 user signature, and signing adds
+// their hyperliquidChain and signatureChainId fields; other actions are
+// signed as L1 actions.
+//
+// Usage:
+//
+//	HL_PRIVATE_KEY=... hlsign sign -in unsigned.json -out signed.json
+//	hlsign verify -in signed.json
+//	hlsign submit -in signed.json
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	hl "github.com/sonirico/go-hyperliquid"
+)
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `usage: hlsign <command> [flags]
+
+commands:
+  sign    sign an unsigned action file with the key in $HL_PRIVATE_KEY
+  verify  print the signer of a signed action file
+  submit  submit a signed action file`)
+	os.Exit(2)
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "sign":
+		err = sign(os.Args[2:])
+	case "verify":
+		err = verify(os.Args[2:])
+	case "submit":
+		err = submit(os.Args[2:])
+	default:
+		usage()
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "hlsign:", err)
+		os.Exit(1)
+	}
+}
+
+func sign(args []string) error {
+	flags := flag.NewFlagSet("sign", flag.ExitOnError)
+	in := flags.String("in", "unsigned.json", "unsigned action file")
+	out := flags.String("out", "signed.json", "signed action file to write")
+	keyEnv := flags.String("key-env", "HL_PRIVATE_KEY", "environment variable holding the hex private key")
+	_ = flags.Parse(args)
+
+	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(os.Getenv(*keyEnv), "0x"))
+	if err != nil {
+		return fmt.Errorf("invalid private key in $%s: %w", *keyEnv, err)
+	}
+
+	unsigned, err := hl.ReadUnsignedAction(*in)
+	if err != nil {
+		return err
+	}
+
+	// Signing needs neither the metadata nor the network
+	exchange := hl.NewExchange(privateKey, "", &hl.Meta{}, "", "", &hl.SpotMeta{})
+	signed, err := exchange.SignAction(unsigned)
+	if err != nil {
+		return err
+	}
+	if err := signed.WriteFile(*out); err != nil {
+		return err
+	}
+
+	fmt.Printf("signed by %s, written to %s\n", signed.Signer, *out)
+	return nil
+}
+
+func verify(args []string) error {
+	flags := flag.NewFlagSet("verify", flag.ExitOnError)
+	in := flags.String("in", "signed.json", "signed action file")
+	_ = flags.Parse(args)
+
+	signed, err := hl.ReadSignedAction(*in)
+	if err != nil {
+		return err
+	}
+	if err := signed.Verify(); err != nil {
+		return err
+	}
+
+	fmt.Printf("signed by %s for mainnet=%t with nonce %d\n", signed.Signer, signed.IsMainnet, signed.Nonce)
+	return nil
+}
+
+func submit(args []string) error {
+	flags := flag.NewFlagSet("submit", flag.ExitOnError)
+	in := flags.String("in", "signed.json", "signed action file")
+	url := flags.String("url", "", "API URL, defaults to the network the action was signed for")
+	_ = flags.Parse(args)
+
+	signed, err := hl.ReadSignedAction(*in)
+	if err != nil {
+		return err
+	}
+
+	baseURL := *url
+	if baseURL == "" {
+		baseURL = hl.TestnetAPIURL
+		if signed.IsMainnet {
+			baseURL = hl.MainnetAPIURL
+		}
+	}
+
+	exchange := hl.NewExchange(nil, baseURL, &hl.Meta{}, "", "", &hl.SpotMeta{})
+	resp, err := exchange.SubmitSignedAction(context.Background(), signed)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(resp))
+	return nil
+}
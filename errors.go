@@ -1,6 +1,9 @@
 package hyperliquid
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 //go:generate easyjson -all
 
@@ -15,10 +18,34 @@ func (e APIError) Error() string {
 }
 
 type ValidationError struct {
+	// Order is the position of the offending order in its batch
+	Order   int
 	Field   string
 	Message string
 }
 
 func (e ValidationError) Error() string {
-	return fmt.Sprintf("validation error on field %s: %s", e.Field, e.Message)
+	return fmt.Sprintf("validation error on order %d field %s: %s", e.Order, e.Field, e.Message)
+}
+
+// ValidationErrors are the violations found by pre-flight order validation
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// ForOrder returns the violations of the order at position i of the batch
+func (e ValidationErrors) ForOrder(i int) []ValidationError {
+	var result []ValidationError
+	for _, err := range e {
+		if err.Order == i {
+			result = append(result, err)
+		}
+	}
+	return result
 }
@@ -36,6 +36,8 @@ func easyjsonD31a5a85DecodeGithubComSoniricoGoHyperliquid(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
+		case "Order":
+			out.Order = int(in.Int())
 		case "Field":
 			out.Field = string(in.String())
 		case "Message":
@@ -55,8 +57,13 @@ func easyjsonD31a5a85EncodeGithubComSoniricoGoHyperliquid(out *jwriter.Writer, i
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Field\":"
+		const prefix string = ",\"Order\":"
 		out.RawString(prefix[1:])
+		out.Int(int(in.Order))
+	}
+	{
+		const prefix string = ",\"Field\":"
+		out.RawString(prefix)
 		out.String(string(in.Field))
 	}
 	{
@@ -1,8 +1,6 @@
 package examples
 
 import (
-	"crypto/rand"
-	"encoding/hex"
 	"testing"
 
 	"github.com/joho/godotenv"
@@ -54,11 +52,7 @@ func TestCancelByCloid(t *testing.T) {
 	exchange := newTestExchange(t)
 
 	// Generate a random cloid
-	cloidBytes := make([]byte, 16)
-	if _, err := rand.Read(cloidBytes); err != nil {
-		t.Fatalf("Failed to generate random cloid: %v", err)
-	}
-	cloid := "0x" + hex.EncodeToString(cloidBytes)
+	cloid := hyperliquid.NewCloid()
 
 	// Place an order with cloid
 	orderReq := hyperliquid.CreateOrderRequest{
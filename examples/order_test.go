@@ -101,7 +101,7 @@ func TestModifyOrder(t *testing.T) {
 
 	// Example usage:
 	modifyReq := hyperliquid.ModifyOrderRequest{
-		Oid: int64(12345),
+		Oid: hyperliquid.OidRef(12345),
 		Order: hyperliquid.CreateOrderRequest{
 			Coin:  "BTC",
 			IsBuy: true,
@@ -111,7 +111,7 @@ func TestModifyOrder(t *testing.T) {
 				Limit: &hyperliquid.LimitOrderType{Tif: hyperliquid.TifGtc},
 			},
 			ReduceOnly:    false,
-			ClientOrderID: func() *string { s := "modified_order_123"; return &s }(),
+			ClientOrderID: func() *hyperliquid.Cloid { c := hyperliquid.NewCloid(); return &c }(),
 		},
 	}
 
@@ -132,7 +132,7 @@ func TestBulkModifyOrders(t *testing.T) {
 	// Example usage:
 	modifyRequests := []hyperliquid.ModifyOrderRequest{
 		{
-			Oid: int64(12345),
+			Oid: hyperliquid.OidRef(12345),
 			Order: hyperliquid.CreateOrderRequest{
 				Coin:  "BTC",
 				IsBuy: true,
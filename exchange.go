@@ -1,18 +1,25 @@
 package hyperliquid
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"encoding/json"
+	"sync/atomic"
 	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 type Exchange struct {
 	client       *Client
+	transport    Transport
 	privateKey   *ecdsa.PrivateKey
 	vault        string
 	accountAddr  string
 	info         *Info
 	expiresAfter *int64
+	validator    *OrderValidator
+	lastNonce    atomic.Int64
 }
 
 func NewExchange(
@@ -24,6 +31,7 @@ func NewExchange(
 ) *Exchange {
 	return &Exchange{
 		client:      NewClient(baseURL),
+		transport:   NewHTTPTransport(baseURL),
 		privateKey:  privateKey,
 		vault:       vaultAddr,
 		accountAddr: accountAddr,
@@ -31,23 +39,60 @@ func NewExchange(
 	}
 }
 
+// WithAgent returns an exchange that signs with an approved agent key and
+// trades for the account of this exchange, or for vaultAddr when it is not
+// empty. It shares the transport, metadata and validator of this exchange.
+func (e *Exchange) WithAgent(agentKey *ecdsa.PrivateKey, vaultAddr string) *Exchange {
+	return &Exchange{
+		client:       e.client,
+		transport:    e.transport,
+		privateKey:   agentKey,
+		vault:        vaultAddr,
+		accountAddr:  e.accountAddress(),
+		info:         e.info,
+		expiresAfter: e.expiresAfter,
+		validator:    e.validator,
+	}
+}
+
+// accountAddress returns the account address, defaulting to the address of
+// the signing key
+func (e *Exchange) accountAddress() string {
+	if e.accountAddr == "" && e.privateKey != nil {
+		return crypto.PubkeyToAddress(e.privateKey.PublicKey).Hex()
+	}
+	return e.accountAddr
+}
+
+// nextNonce returns the current time in milliseconds, bumped past the last
+// nonce so that actions sent within the same millisecond are not rejected as
+// duplicates
+func (e *Exchange) nextNonce() int64 {
+	for {
+		last := e.lastNonce.Load()
+		nonce := time.Now().UnixMilli()
+		if nonce <= last {
+			nonce = last + 1
+		}
+		if e.lastNonce.CompareAndSwap(last, nonce) {
+			return nonce
+		}
+	}
+}
+
 // executeAction executes an action and unmarshals the response into the given result
 func (e *Exchange) executeAction(action any, result any) error {
-	timestamp := time.Now().UnixMilli()
-
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		timestamp,
-		e.expiresAfter,
-		e.client.baseURL == MainnetAPIURL,
-	)
+	unsigned, err := e.BuildAction(action)
 	if err != nil {
 		return err
 	}
 
-	resp, err := e.postAction(action, sig, timestamp)
+	signed, err := e.SignAction(unsigned)
+	if err != nil {
+		return err
+	}
+
+	resp, err := e.submit(context.Background(), signed)
 	if err != nil {
 		return err
 	}
@@ -64,31 +109,71 @@ func (e *Exchange) postAction(
 	signature SignatureResult,
 	nonce int64,
 ) ([]byte, error) {
+	payload := newExchangePayload(action, signature, nonce, e.vault, e.expiresAfter)
+	return e.transport.Exchange(context.Background(), payload)
+}
+
+// SetTransport sets the transport used to submit signed actions and the info
+// queries issued by the exchange itself (mids, user state, ...)
+func (e *Exchange) SetTransport(transport Transport) {
+	e.transport = transport
+	e.info.SetTransport(transport)
+}
+
+// SetValidator sets the validator that orders and modifies are checked with
+// before they are signed. A nil validator disables the checks.
+func (e *Exchange) SetValidator(validator *OrderValidator) {
+	e.validator = validator
+}
+
+// validate runs the validator, if any, on orders sent with grouping
+func (e *Exchange) validate(orders []CreateOrderRequest, grouping Grouping) error {
+	if e.validator == nil {
+		return nil
+	}
+	return e.validator.Validate(e.info, orders, grouping)
+}
+
+// LoadPerpDexs makes the assets of builder-deployed perp dexes tradable by
+// "dex:COIN" name. See Info.LoadPerpDexs.
+func (e *Exchange) LoadPerpDexs(dexes ...string) error {
+	return e.info.LoadPerpDexs(dexes...)
+}
+
+// newExchangePayload builds the body of an /exchange request. It is shared by
+// the REST client and websocket post requests.
+func newExchangePayload(
+	action any,
+	signature SignatureResult,
+	nonce int64,
+	vault string,
+	expiresAfter *int64,
+) map[string]any {
 	payload := map[string]any{
 		"action":    action,
 		"nonce":     nonce,
 		"signature": signature,
 	}
 
-	if e.vault != "" {
+	if vault != "" {
 		// Handle vault address based on action type
 		if actionMap, ok := action.(map[string]any); ok {
 			if actionMap["type"] != "usdClassTransfer" {
-				payload["vaultAddress"] = e.vault
+				payload["vaultAddress"] = vault
 			} else {
 				payload["vaultAddress"] = nil
 			}
 		} else {
 			// For struct types, we need to use reflection or type assertion
 			// For now, assume it's not usdClassTransfer
-			payload["vaultAddress"] = e.vault
+			payload["vaultAddress"] = vault
 		}
 	}
 
 	// Add expiration time if set
-	if e.expiresAfter != nil {
-		payload["expiresAfter"] = *e.expiresAfter
+	if expiresAfter != nil {
+		payload["expiresAfter"] = *expiresAfter
 	}
 
-	return e.client.post("/exchange", payload)
+	return payload
 }
@@ -0,0 +1,392 @@
+package hyperliquid
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// UnsignedAction is an action with the nonce and options it is signed with.
+// Build it with Exchange.BuildAction.
+type UnsignedAction struct {
+	Action       any    `json:"action"`
+	Nonce        int64  `json:"nonce"`
+	VaultAddress string `json:"vaultAddress,omitempty"`
+	ExpiresAfter *int64 `json:"expiresAfter,omitempty"`
+	IsMainnet    bool   `json:"isMainnet"`
+}
+
+// SignedAction is a signed action ready to be submitted, possibly from
+// another machine. Signer is the address of the key that signed it.
+type SignedAction struct {
+	Action       any             `json:"action"`
+	Nonce        int64           `json:"nonce"`
+	VaultAddress string          `json:"vaultAddress,omitempty"`
+	ExpiresAfter *int64          `json:"expiresAfter,omitempty"`
+	IsMainnet    bool            `json:"isMainnet"`
+	Signer       string          `json:"signer"`
+	Signature    SignatureResult `json:"signature"`
+}
+
+// UnmarshalJSON keeps the action as a RawAction so it hashes like it was
+// signed
+func (a *UnsignedAction) UnmarshalJSON(data []byte) error {
+	type plain UnsignedAction
+	var decoded struct {
+		plain
+		Action RawAction `json:"action"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*a = UnsignedAction(decoded.plain)
+	a.Action = decoded.Action
+	return nil
+}
+
+// UnmarshalJSON keeps the action as a RawAction so it hashes like it was
+// signed
+func (a *SignedAction) UnmarshalJSON(data []byte) error {
+	type plain SignedAction
+	var decoded struct {
+		plain
+		Action RawAction `json:"action"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*a = SignedAction(decoded.plain)
+	a.Action = decoded.Action
+	return nil
+}
+
+// Verify checks that the signature was produced by Signer
+func (a *SignedAction) Verify() error {
+	fields, typ, userSigned, err := userSignedAction(a.Action)
+	if err != nil {
+		return err
+	}
+
+	var signer string
+	if userSigned {
+		if chain := hyperliquidChain(a.IsMainnet); fields["hyperliquidChain"] != chain {
+			return fmt.Errorf("action was signed for %v, not %s", fields["hyperliquidChain"], chain)
+		}
+		signer, err = RecoverUserSignedSigner(fields, typ.fields, typ.primaryType, a.Signature)
+	} else {
+		signer, err = RecoverL1Signer(
+			a.Action,
+			a.VaultAddress,
+			a.Nonce,
+			a.ExpiresAfter,
+			a.Signature,
+			a.IsMainnet,
+		)
+	}
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(signer, a.Signer) {
+		return fmt.Errorf("action was signed by %s, not %s", signer, a.Signer)
+	}
+	return nil
+}
+
+// WriteFile writes the action as indented JSON. It fails if the action would
+// not hash the same once read back, e.g. a float field holding a whole
+// number.
+func (a *UnsignedAction) WriteFile(path string) error {
+	raw, err := rawAction(a.Action, a.VaultAddress, a.Nonce, a.ExpiresAfter)
+	if err != nil {
+		return err
+	}
+	file := *a
+	file.Action = raw
+	return writeJSONFile(path, file)
+}
+
+// WriteFile writes the action as indented JSON. It fails if the action would
+// not hash the same once read back, e.g. a float field holding a whole
+// number.
+func (a *SignedAction) WriteFile(path string) error {
+	raw, err := rawAction(a.Action, a.VaultAddress, a.Nonce, a.ExpiresAfter)
+	if err != nil {
+		return err
+	}
+	file := *a
+	file.Action = raw
+	return writeJSONFile(path, file)
+}
+
+// ReadUnsignedAction reads an action written by UnsignedAction.WriteFile
+func ReadUnsignedAction(path string) (*UnsignedAction, error) {
+	var action UnsignedAction
+	if err := readJSONFile(path, &action); err != nil {
+		return nil, err
+	}
+	return &action, nil
+}
+
+// ReadSignedAction reads an action written by SignedAction.WriteFile
+func ReadSignedAction(path string) (*SignedAction, error) {
+	var action SignedAction
+	if err := readJSONFile(path, &action); err != nil {
+		return nil, err
+	}
+	return &action, nil
+}
+
+// BuildAction assigns a nonce to action. Actions that carry their own time or
+// nonce, like transfers and withdrawals, are signed with it and must have it
+// set. User signed actions are not sent for the vault of the exchange, as
+// their signature does not cover it.
+func (e *Exchange) BuildAction(action any) (*UnsignedAction, error) {
+	nonce, ok := actionNonce(action)
+	if !ok {
+		nonce = e.nextNonce()
+	} else if nonce <= 0 {
+		return nil, fmt.Errorf("%T must have its time or nonce set", action)
+	}
+
+	_, _, userSigned, err := userSignedAction(action)
+	if err != nil {
+		return nil, err
+	}
+	vault := e.vault
+	if userSigned {
+		vault = ""
+	}
+
+	return &UnsignedAction{
+		Action:       action,
+		Nonce:        nonce,
+		VaultAddress: vault,
+		ExpiresAfter: e.expiresAfter,
+		IsMainnet:    e.client.baseURL == MainnetAPIURL,
+	}, nil
+}
+
+// SignAction signs an action with the key of the exchange. It needs no
+// network access. Transfers, withdrawals and the other user signed actions
+// get an EIP-712 user signature instead of an L1 one: their hyperliquidChain
+// and signatureChainId are set and the signed action holds them.
+func (e *Exchange) SignAction(action *UnsignedAction) (*SignedAction, error) {
+	fields, typ, userSigned, err := userSignedAction(action.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	signed := action.Action
+	var sig SignatureResult
+	if userSigned {
+		sig, err = SignUserSignedAction(
+			e.privateKey,
+			fields,
+			typ.fields,
+			typ.primaryType,
+			action.IsMainnet,
+		)
+		if err != nil {
+			return nil, err
+		}
+		signed, err = userSignedRawAction(fields, typ)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		sig, err = SignL1Action(
+			e.privateKey,
+			action.Action,
+			action.VaultAddress,
+			action.Nonce,
+			action.ExpiresAfter,
+			action.IsMainnet,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &SignedAction{
+		Action:       signed,
+		Nonce:        action.Nonce,
+		VaultAddress: action.VaultAddress,
+		ExpiresAfter: action.ExpiresAfter,
+		IsMainnet:    action.IsMainnet,
+		Signer:       crypto.PubkeyToAddress(e.privateKey.PublicKey).Hex(),
+		Signature:    sig,
+	}, nil
+}
+
+// SubmitSignedAction verifies and sends a signed action, which may have been
+// signed by another exchange, and returns the raw response
+func (e *Exchange) SubmitSignedAction(ctx context.Context, action *SignedAction) ([]byte, error) {
+	if isMainnet := e.client.baseURL == MainnetAPIURL; action.IsMainnet != isMainnet {
+		return nil, fmt.Errorf(
+			"action was signed for mainnet=%t but is submitted to mainnet=%t",
+			action.IsMainnet,
+			isMainnet,
+		)
+	}
+	if err := action.Verify(); err != nil {
+		return nil, err
+	}
+	return e.submit(ctx, action)
+}
+
+// submit sends a signed action without verifying it
+func (e *Exchange) submit(ctx context.Context, action *SignedAction) ([]byte, error) {
+	payload := newExchangePayload(
+		action.Action,
+		action.Signature,
+		action.Nonce,
+		action.VaultAddress,
+		action.ExpiresAfter,
+	)
+	return e.transport.Exchange(ctx, payload)
+}
+
+// userSignedAction returns the fields of action if its type is signed with an
+// EIP-712 user signature rather than as an L1 action
+func userSignedAction(action any) (map[string]any, userSignedType, bool, error) {
+	data, err := json.Marshal(action)
+	if err != nil {
+		return nil, userSignedType{}, false, fmt.Errorf("failed to marshal action: %w", err)
+	}
+
+	var fields map[string]any
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&fields); err != nil {
+		return nil, userSignedType{}, false, fmt.Errorf("failed to decode action: %w", err)
+	}
+
+	actionType, _ := fields["type"].(string)
+	typ, ok := userSignedTypes[actionType]
+	if !ok {
+		return nil, userSignedType{}, false, nil
+	}
+	if actionType == "multiSig" {
+		return nil, userSignedType{}, false, errors.New("multi-sig actions are signed by Exchange.MultiSig")
+	}
+	return fields, typ, true, nil
+}
+
+// userSignedRawAction encodes the fields of a user signed action in the order
+// the API hashes them when the action is nested in a multi-sig action: type,
+// signatureChainId, then the fields of its EIP-712 type
+func userSignedRawAction(fields map[string]any, typ userSignedType) (RawAction, error) {
+	keys := []string{"type", "signatureChainId"}
+	for _, field := range typ.fields {
+		keys = append(keys, field.Name)
+	}
+	listed := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		listed[key] = true
+	}
+	var rest []string
+	for key := range fields {
+		if !listed[key] {
+			rest = append(rest, key)
+		}
+	}
+	sort.Strings(rest)
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for _, key := range append(keys, rest...) {
+		value, ok := fields[key]
+		if !ok {
+			continue
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal action: %w", err)
+		}
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		name, _ := json.Marshal(key)
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(data)
+	}
+	buf.WriteByte('}')
+	return RawAction(buf.Bytes()), nil
+}
+
+// actionNonce returns the nonce of the actions that embed it
+func actionNonce(action any) (int64, bool) {
+	switch a := action.(type) {
+	case UsdClassTransferAction:
+		return a.Nonce, true
+	case SpotTransferAction:
+		return a.Time, true
+	case UsdTransferAction:
+		return a.Time, true
+	case TokenDelegateAction:
+		return a.Nonce, true
+	case WithdrawFromBridgeAction:
+		return a.Time, true
+	case ApproveAgentAction:
+		return a.Nonce, true
+	case ApproveBuilderFeeAction:
+		return a.Nonce, true
+	case ConvertToMultiSigUserAction:
+		return a.Nonce, true
+	default:
+		return 0, false
+	}
+}
+
+// rawAction encodes action as JSON and checks that it hashes like action
+func rawAction(action any, vault string, nonce int64, expiresAfter *int64) (RawAction, error) {
+	if raw, ok := action.(RawAction); ok {
+		return raw, nil
+	}
+
+	data, err := json.Marshal(action)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal action: %w", err)
+	}
+	raw := RawAction(data)
+
+	want, err := ActionHash(action, vault, nonce, expiresAfter)
+	if err != nil {
+		return nil, err
+	}
+	got, err := ActionHash(raw, vault, nonce, expiresAfter)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(want, got) {
+		return nil, errors.New("action does not hash the same once encoded as JSON")
+	}
+	return raw, nil
+}
+
+func writeJSONFile(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o600)
+}
+
+func readJSONFile(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOfflineSigning(t *testing.T) {
+	transport := &memoryTransport{
+		exchangeResponse: func(map[string]any) ([]byte, error) {
+			return []byte(`{"status":"ok","response":{"type":"default"}}`), nil
+		},
+	}
+	exchange := newMemoryExchange(t, transport)
+	dir := t.TempDir()
+
+	withdrawal := WithdrawFromBridgeAction{
+		Type:        "withdraw3",
+		Destination: "0x5e9ee1089755c3435139848e47e6635505d5a13a",
+		Amount:      "100.000000",
+		Time:        1700000000000,
+	}
+	unsigned, err := exchange.BuildAction(withdrawal)
+	require.NoError(t, err)
+	assert.Equal(t, withdrawal.Time, unsigned.Nonce)
+	assert.False(t, unsigned.IsMainnet)
+
+	// The unsigned action travels to the signing machine and back
+	unsignedPath := filepath.Join(dir, "unsigned.json")
+	require.NoError(t, unsigned.WriteFile(unsignedPath))
+	unsigned, err = ReadUnsignedAction(unsignedPath)
+	require.NoError(t, err)
+
+	signed, err := exchange.SignAction(unsigned)
+	require.NoError(t, err)
+	signedPath := filepath.Join(dir, "signed.json")
+	require.NoError(t, signed.WriteFile(signedPath))
+
+	signed, err = ReadSignedAction(signedPath)
+	require.NoError(t, err)
+	require.NoError(t, signed.Verify())
+
+	// Withdrawals get an EIP-712 user signature, not an L1 one
+	direct, err := SignUserSignedAction(
+		exchange.privateKey,
+		map[string]any{
+			"destination": withdrawal.Destination,
+			"amount":      withdrawal.Amount,
+			"time":        withdrawal.Time,
+		},
+		userSignedTypes["withdraw3"].fields,
+		"HyperliquidTransaction:Withdraw",
+		false,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, direct, signed.Signature)
+
+	resp, err := exchange.SubmitSignedAction(context.Background(), signed)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"status":"ok","response":{"type":"default"}}`, string(resp))
+
+	require.Len(t, transport.exchangePayloads, 1)
+	var payload map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(transport.exchangePayloads[0], &payload))
+	assert.JSONEq(
+		t,
+		`{"type":"withdraw3","destination":"0x5e9ee1089755c3435139848e47e6635505d5a13a","amount":"100.000000","time":1700000000000,`+
+			`"hyperliquidChain":"Testnet","signatureChainId":"0x66eee"}`,
+		string(payload["action"]),
+	)
+	assert.JSONEq(t, `1700000000000`, string(payload["nonce"]))
+
+	var sent map[string]any
+	require.NoError(t, json.Unmarshal(payload["action"], &sent))
+	var signature SignatureResult
+	require.NoError(t, json.Unmarshal(payload["signature"], &signature))
+	signer, err := RecoverUserSignedActionSigner(sent, signature)
+	require.NoError(t, err)
+	assert.Equal(t, signed.Signer, signer)
+}
+
+func TestOfflineSigning_L1Action(t *testing.T) {
+	exchange := newMemoryExchange(t, &memoryTransport{})
+
+	action := CancelAction{Type: "cancel", Cancels: []CancelOrderWire{{Asset: 1, OrderID: 7}}}
+	unsigned, err := exchange.BuildAction(action)
+	require.NoError(t, err)
+	signed, err := exchange.SignAction(unsigned)
+	require.NoError(t, err)
+	require.NoError(t, signed.Verify())
+
+	direct, err := SignL1Action(exchange.privateKey, action, "", unsigned.Nonce, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, direct, signed.Signature)
+	assert.Equal(t, action, signed.Action)
+}
+
+func TestOfflineSigning_Errors(t *testing.T) {
+	exchange := newMemoryExchange(t, &memoryTransport{})
+
+	t.Run("missing nonce", func(t *testing.T) {
+		_, err := exchange.BuildAction(UsdTransferAction{Type: "usdSend", Amount: "1"})
+		assert.ErrorContains(t, err, "must have its time or nonce set")
+	})
+
+	t.Run("tampered action", func(t *testing.T) {
+		unsigned, err := exchange.BuildAction(
+			UsdTransferAction{Type: "usdSend", Destination: "0x1", Amount: "1", Time: 1},
+		)
+		require.NoError(t, err)
+		signed, err := exchange.SignAction(unsigned)
+		require.NoError(t, err)
+
+		var fields map[string]any
+		require.NoError(t, json.Unmarshal(signed.Action.(RawAction), &fields))
+		fields["destination"] = "0x2"
+		signed.Action = RawAction(mustMarshal(t, fields))
+		_, err = exchange.SubmitSignedAction(context.Background(), signed)
+		assert.ErrorContains(t, err, "action was signed by")
+
+		fields["hyperliquidChain"] = "Mainnet"
+		signed.Action = RawAction(mustMarshal(t, fields))
+		assert.EqualError(t, signed.Verify(), "action was signed for Mainnet, not Testnet")
+	})
+
+	t.Run("multi-sig action", func(t *testing.T) {
+		_, err := exchange.BuildAction(MultiSigAction{Type: "multiSig"})
+		assert.ErrorContains(t, err, "signed by Exchange.MultiSig")
+
+		_, err = exchange.SignAction(&UnsignedAction{Action: MultiSigAction{Type: "multiSig"}})
+		assert.ErrorContains(t, err, "signed by Exchange.MultiSig")
+	})
+
+	t.Run("wrong network", func(t *testing.T) {
+		unsigned, err := exchange.BuildAction(CancelAction{Type: "cancel"})
+		require.NoError(t, err)
+		unsigned.IsMainnet = true
+		signed, err := exchange.SignAction(unsigned)
+		require.NoError(t, err)
+
+		_, err = exchange.SubmitSignedAction(context.Background(), signed)
+		assert.ErrorContains(t, err, "signed for mainnet=true")
+	})
+
+	t.Run("action changes once encoded", func(t *testing.T) {
+		unsigned, err := exchange.BuildAction(
+			UpdateIsolatedMarginAction{Type: "updateIsolatedMargin", Asset: 1, IsBuy: true, Ntli: 100},
+		)
+		require.NoError(t, err)
+		err = unsigned.WriteFile(filepath.Join(t.TempDir(), "unsigned.json"))
+		assert.ErrorContains(t, err, "does not hash the same")
+	})
+}
+
+func TestExchange_UserSignedActions(t *testing.T) {
+	transport := &memoryTransport{
+		exchangeResponse: func(map[string]any) ([]byte, error) {
+			return []byte(`{"status":"ok","response":{"type":"default"}}`), nil
+		},
+	}
+	exchange := newMemoryExchange(t, transport)
+	exchange.vault = "0x0000000000000000000000000000000000000009"
+	signer := crypto.PubkeyToAddress(exchange.privateKey.PublicKey).Hex()
+	destination := "0x0000000000000000000000000000000000000001"
+
+	calls := map[string]func() error{
+		"usdSend": func() error {
+			_, err := exchange.UsdTransfer(1, destination)
+			return err
+		},
+		"spotSend": func() error {
+			_, err := exchange.SpotTransfer(1, destination, "PURR:0xc4bf3f870c0e9465323c0b6ed28096c2")
+			return err
+		},
+		"withdraw3": func() error {
+			_, err := exchange.WithdrawFromBridge(1, destination)
+			return err
+		},
+		"usdClassTransfer": func() error {
+			_, err := exchange.UsdClassTransfer(1, true)
+			return err
+		},
+		"tokenDelegate": func() error {
+			_, err := exchange.TokenDelegate(destination, 1, false)
+			return err
+		},
+		"approveAgent": func() error {
+			// Unnamed, so the empty name it is signed with is not sent
+			_, _, err := exchange.ApproveAgent(nil)
+			return err
+		},
+		"approveBuilderFee": func() error {
+			_, err := exchange.ApproveBuilderFee(destination, "0.001%")
+			return err
+		},
+		"convertToMultiSigUser": func() error {
+			_, err := exchange.ConvertToMultiSigUser([]string{destination}, 1)
+			return err
+		},
+	}
+
+	for actionType, call := range calls {
+		t.Run(actionType, func(t *testing.T) {
+			transport.exchangePayloads = nil
+			require.NoError(t, call())
+			require.Len(t, transport.exchangePayloads, 1)
+
+			var payload struct {
+				Action       map[string]any  `json:"action"`
+				Signature    SignatureResult `json:"signature"`
+				VaultAddress *string         `json:"vaultAddress"`
+			}
+			require.NoError(t, json.Unmarshal(transport.exchangePayloads[0], &payload))
+			assert.Equal(t, actionType, payload.Action["type"])
+			assert.Nil(t, payload.VaultAddress)
+
+			recovered, err := RecoverUserSignedActionSigner(payload.Action, payload.Signature)
+			require.NoError(t, err)
+			assert.Equal(t, signer, recovered)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package hyperliquid
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 type CreateOrderRequest struct {
@@ -12,13 +13,13 @@ type CreateOrderRequest struct {
 	Size          float64
 	ReduceOnly    bool
 	OrderType     OrderType
-	ClientOrderID *string
+	ClientOrderID *Cloid
 }
 
 type OrderStatusResting struct {
-	Oid      int64   `json:"oid"`
-	ClientID *string `json:"cloid"`
-	Status   string  `json:"status"`
+	Oid      int64  `json:"oid"`
+	ClientID *Cloid `json:"cloid"`
+	Status   string `json:"status"`
 }
 
 type OrderStatusFilled struct {
@@ -27,10 +28,44 @@ type OrderStatusFilled struct {
 	Oid     int    `json:"oid"`
 }
 
+// OrderStatusWaiting is the status of a TP/SL leg that is not live yet
+type OrderStatusWaiting string
+
+const (
+	// OrderStatusWaitingForFill is reported for normalTpsl legs until the
+	// parent order fills
+	OrderStatusWaitingForFill OrderStatusWaiting = "waitingForFill"
+	// OrderStatusWaitingForTrigger is reported for positionTpsl legs
+	OrderStatusWaitingForTrigger OrderStatusWaiting = "waitingForTrigger"
+)
+
 type OrderStatus struct {
 	Resting *OrderStatusResting `json:"resting,omitempty"`
 	Filled  *OrderStatusFilled  `json:"filled,omitempty"`
 	Error   *string             `json:"error,omitempty"`
+	// Waiting is set instead of the fields above when the API reports the
+	// status as a bare string
+	Waiting OrderStatusWaiting `json:"-"`
+}
+
+func (s *OrderStatus) UnmarshalJSON(data []byte) error {
+	var waiting string
+	if err := json.Unmarshal(data, &waiting); err == nil {
+		*s = OrderStatus{Waiting: OrderStatusWaiting(waiting)}
+		return nil
+	}
+
+	type orderStatus OrderStatus
+	return json.Unmarshal(data, (*orderStatus)(s))
+}
+
+func (s OrderStatus) MarshalJSON() ([]byte, error) {
+	if s.Waiting != "" {
+		return json.Marshal(string(s.Waiting))
+	}
+
+	type orderStatus OrderStatus
+	return json.Marshal(orderStatus(s))
 }
 
 func (s *OrderStatus) String() string {
@@ -42,53 +77,87 @@ type OrderResponse struct {
 	Statuses []OrderStatus
 }
 
+// orderTypeToWire converts an order type to its wire format
+func orderTypeToWire(orderType OrderType) (OrderTypeWire, error) {
+	switch {
+	case orderType.Limit != nil:
+		return OrderTypeWire{
+			Limit: &LimitOrderTypeWire{Tif: string(orderType.Limit.Tif)},
+		}, nil
+	case orderType.Trigger != nil:
+		triggerPxWire, err := floatToWire(orderType.Trigger.TriggerPx)
+		if err != nil {
+			return OrderTypeWire{}, fmt.Errorf("failed to wire trigger price: %w", err)
+		}
+		return OrderTypeWire{
+			Trigger: &TriggerOrderTypeWire{
+				IsMarket:  orderType.Trigger.IsMarket,
+				TriggerPx: triggerPxWire,
+				Tpsl:      orderType.Trigger.Tpsl,
+			},
+		}, nil
+	default:
+		return OrderTypeWire{}, fmt.Errorf("order type must be limit or trigger")
+	}
+}
+
+// orderRequestToWire converts an order request to its wire format
+func orderRequestToWire(e *Exchange, order CreateOrderRequest) (OrderWire, error) {
+	priceWire, err := floatToWire(order.Price)
+	if err != nil {
+		return OrderWire{}, fmt.Errorf("failed to wire price: %w", err)
+	}
+
+	sizeWire, err := floatToWire(order.Size)
+	if err != nil {
+		return OrderWire{}, fmt.Errorf("failed to wire size: %w", err)
+	}
+
+	orderTypeWire, err := orderTypeToWire(order.OrderType)
+	if err != nil {
+		return OrderWire{}, err
+	}
+
+	asset, err := e.info.AssetByName(order.Coin)
+	if err != nil {
+		return OrderWire{}, err
+	}
+
+	return OrderWire{
+		Asset:      asset,
+		IsBuy:      order.IsBuy,
+		LimitPx:    priceWire,
+		Size:       sizeWire,
+		ReduceOnly: order.ReduceOnly,
+		OrderType:  orderTypeWire,
+		Cloid:      order.ClientOrderID,
+	}, nil
+}
+
 func newCreateOrderAction(
 	e *Exchange,
 	orders []CreateOrderRequest,
+	grouping Grouping,
 	info *BuilderInfo,
 ) (OrderAction, error) {
 	orderRequests := make([]OrderWire, len(orders))
 	for i, order := range orders {
-		priceWire, err := floatToWire(order.Price)
+		orderWire, err := orderRequestToWire(e, order)
 		if err != nil {
-			return OrderAction{}, fmt.Errorf("failed to wire price for order %d: %w", i, err)
-		}
-
-		sizeWire, err := floatToWire(order.Size)
-		if err != nil {
-			return OrderAction{}, fmt.Errorf("failed to wire size for order %d: %w", i, err)
-		}
-
-		// Build order type map with proper field ordering
-		orderTypeMap := make(map[string]any)
-		if order.OrderType.Limit != nil {
-			orderTypeMap["limit"] = map[string]any{
-				"tif": order.OrderType.Limit.Tif,
-			}
-		} else if order.OrderType.Trigger != nil {
-			orderTypeMap["trigger"] = map[string]any{
-				"triggerPx": order.OrderType.Trigger.TriggerPx,
-				"isMarket":  order.OrderType.Trigger.IsMarket,
-				"tpsl":      order.OrderType.Trigger.Tpsl,
-			}
-		}
-
-		orderWire := OrderWire{
-			Asset:      e.info.NameToAsset(order.Coin),
-			IsBuy:      order.IsBuy,
-			LimitPx:    priceWire,
-			Size:       sizeWire,
-			ReduceOnly: order.ReduceOnly,
-			OrderType:  orderTypeMap,
-			Cloid:      order.ClientOrderID,
+			return OrderAction{}, fmt.Errorf("order %d: %w", i, err)
 		}
 		orderRequests[i] = orderWire
 	}
 
+	// The builder address is signed lowercased, like the reference SDK does
+	if info != nil {
+		info = &BuilderInfo{Builder: strings.ToLower(info.Builder), Fee: info.Fee}
+	}
+
 	res := OrderAction{
 		Type:     "order",
 		Orders:   orderRequests,
-		Grouping: string(GroupingNA),
+		Grouping: string(grouping),
 		Builder:  info,
 	}
 
@@ -122,7 +191,20 @@ func (e *Exchange) BulkOrders(
 	orders []CreateOrderRequest,
 	builder *BuilderInfo,
 ) (result *APIResponse[OrderResponse], err error) {
-	action, err := newCreateOrderAction(e, orders, builder)
+	return e.groupedOrders(orders, GroupingNA, builder)
+}
+
+// groupedOrders submits orders as a single action with the given grouping
+func (e *Exchange) groupedOrders(
+	orders []CreateOrderRequest,
+	grouping Grouping,
+	builder *BuilderInfo,
+) (result *APIResponse[OrderResponse], err error) {
+	if err := e.validate(orders, grouping); err != nil {
+		return nil, err
+	}
+
+	action, err := newCreateOrderAction(e, orders, grouping, builder)
 	if err != nil {
 		return nil, err
 	}
@@ -144,7 +226,7 @@ func (e *Exchange) BulkOrders(
 }
 
 type ModifyOrderRequest struct {
-	Oid   any // can be int64 or Cloid
+	Oid   OrderRef // OidRef or CloidRef
 	Order CreateOrderRequest
 }
 
@@ -152,42 +234,19 @@ func newModifyOrderAction(
 	e *Exchange,
 	modifyRequest ModifyOrderRequest,
 ) (ModifyAction, error) {
-	priceWire, err := floatToWire(modifyRequest.Order.Price)
-	if err != nil {
-		return ModifyAction{}, fmt.Errorf("failed to wire price: %w", err)
+	if modifyRequest.Oid.IsZero() {
+		return ModifyAction{}, errEmptyOrderRef
 	}
 
-	sizeWire, err := floatToWire(modifyRequest.Order.Size)
+	orderWire, err := orderRequestToWire(e, modifyRequest.Order)
 	if err != nil {
-		return ModifyAction{}, fmt.Errorf("failed to wire size: %w", err)
-	}
-
-	// Build order type map with proper field ordering
-	orderTypeMap := make(map[string]any)
-	if modifyRequest.Order.OrderType.Limit != nil {
-		orderTypeMap["limit"] = map[string]any{
-			"tif": modifyRequest.Order.OrderType.Limit.Tif,
-		}
-	} else if modifyRequest.Order.OrderType.Trigger != nil {
-		orderTypeMap["trigger"] = map[string]any{
-			"triggerPx": modifyRequest.Order.OrderType.Trigger.TriggerPx,
-			"isMarket":  modifyRequest.Order.OrderType.Trigger.IsMarket,
-			"tpsl":      modifyRequest.Order.OrderType.Trigger.Tpsl,
-		}
+		return ModifyAction{}, err
 	}
 
 	return ModifyAction{
-		Type: "modify",
-		Oid:  modifyRequest.Oid,
-		Order: OrderWire{
-			Asset:      e.info.NameToAsset(modifyRequest.Order.Coin),
-			IsBuy:      modifyRequest.Order.IsBuy,
-			LimitPx:    priceWire,
-			Size:       sizeWire,
-			ReduceOnly: modifyRequest.Order.ReduceOnly,
-			OrderType:  orderTypeMap,
-			Cloid:      modifyRequest.Order.ClientOrderID,
-		},
+		Type:  "modify",
+		Oid:   modifyRequest.Oid,
+		Order: orderWire,
 	}, nil
 }
 
@@ -195,13 +254,13 @@ func newModifyOrdersAction(
 	e *Exchange,
 	modifyRequests []ModifyOrderRequest,
 ) (BatchModifyAction, error) {
-	modifies := make([]ModifyAction, len(modifyRequests))
+	modifies := make([]ModifyWire, len(modifyRequests))
 	for i, req := range modifyRequests {
 		modify, err := newModifyOrderAction(e, req)
 		if err != nil {
 			return BatchModifyAction{}, fmt.Errorf("failed to create modify request %d: %w", i, err)
 		}
-		modifies[i] = modify
+		modifies[i] = ModifyWire{Oid: modify.Oid, Order: modify.Order}
 	}
 
 	return BatchModifyAction{
@@ -214,6 +273,10 @@ func newModifyOrdersAction(
 func (e *Exchange) ModifyOrder(
 	req ModifyOrderRequest,
 ) (result OrderStatus, err error) {
+	if err = e.validate([]CreateOrderRequest{req.Order}, GroupingNA); err != nil {
+		return
+	}
+
 	resp := APIResponse[OrderResponse]{}
 	action, err := newModifyOrderAction(e, req)
 	if err != nil {
@@ -244,6 +307,14 @@ func (e *Exchange) ModifyOrder(
 func (e *Exchange) BulkModifyOrders(
 	modifyRequests []ModifyOrderRequest,
 ) ([]OrderStatus, error) {
+	orders := make([]CreateOrderRequest, len(modifyRequests))
+	for i, req := range modifyRequests {
+		orders[i] = req.Order
+	}
+	if err := e.validate(orders, GroupingNA); err != nil {
+		return nil, err
+	}
+
 	resp := APIResponse[OrderResponse]{}
 	action, err := newModifyOrdersAction(e, modifyRequests)
 	if err != nil {
@@ -274,7 +345,7 @@ func (e *Exchange) MarketOpen(
 	sz float64,
 	px *float64,
 	slippage float64,
-	cloid *string,
+	cloid *Cloid,
 	builder *BuilderInfo,
 ) (res OrderStatus, err error) {
 	slippagePrice, err := e.SlippagePrice(name, isBuy, slippage, px)
@@ -303,7 +374,7 @@ func (e *Exchange) MarketClose(
 	sz *float64,
 	px *float64,
 	slippage float64,
-	cloid *string,
+	cloid *Cloid,
 	builder *BuilderInfo,
 ) (OrderStatus, error) {
 	address := e.accountAddr
@@ -62,13 +62,28 @@ func (e *Exchange) BulkCancel(
 	return
 }
 
+// CancelByRef cancels the order ref refers to, by oid or by cloid
+func (e *Exchange) CancelByRef(
+	coin string,
+	ref OrderRef,
+) (res *APIResponse[CancelOrderResponse], err error) {
+	if oid, ok := ref.Oid(); ok {
+		return e.Cancel(coin, oid)
+	}
+	if cloid, ok := ref.Cloid(); ok {
+		return e.CancelByCloid(coin, cloid)
+	}
+	return nil, errEmptyOrderRef
+}
+
 type CancelOrderRequestByCloid struct {
 	Coin  string
-	Cloid string
+	Cloid Cloid
 }
 
 func (e *Exchange) CancelByCloid(
-	coin, cloid string,
+	coin string,
+	cloid Cloid,
 ) (res *APIResponse[CancelOrderResponse], err error) {
 	return e.BulkCancelByCloids([]CancelOrderRequestByCloid{
 		{
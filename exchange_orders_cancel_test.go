@@ -18,7 +18,7 @@ func TestCancelByCloid(t *testing.T) {
 		order      CreateOrderRequest
 		coin       string
 		// used for cancelling a non existent
-		cloid *string
+		cloid *Cloid
 		// If doubleCancel is true, we attempt to cancel the same OID twice to exercise the error path.
 		doubleCancel bool
 		wantErr      string
@@ -38,7 +38,7 @@ func TestCancelByCloid(t *testing.T) {
 				OrderType: OrderType{
 					Limit: &LimitOrderType{Tif: TifGtc},
 				},
-				ClientOrderID: stringPtr("0x285ad26a251f390c83d065af51e3f8d9"),
+				ClientOrderID: cloidPtr("0x285ad26a251f390c83d065af51e3f8d9"),
 			},
 			coin:   "DOGE",
 			record: false,
@@ -48,7 +48,7 @@ func TestCancelByCloid(t *testing.T) {
 			cassetteName: "CancelByCloid",
 			placeFirst:   false,
 			coin:         "BTC",
-			cloid:        stringPtr("0x0000000000000000000000000000fe54"),
+			cloid:        cloidPtr("0x0000000000000000000000000000fe54"),
 			wantErr:      "Order was never placed, already canceled, or filled.",
 			record:       false,
 		},
@@ -64,7 +64,7 @@ func TestCancelByCloid(t *testing.T) {
 		// 		OrderType: OrderType{
 		// 			Limit: &LimitOrderType{Tif: TifGtc},
 		// 		},
-		// 		ClientOrderID: stringPtr("0x185ad26a251f390c83d065af51e3f8d8"),
+		// 		ClientOrderID: cloidPtr("0x185ad26a251f390c83d065af51e3f8d8"),
 		// 	},
 		// 	coin:         "KAS",
 		// 	doubleCancel: true,
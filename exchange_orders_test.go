@@ -256,12 +256,12 @@ func TestOrders(t *testing.T) {
 						Tif: TifGtc,
 					},
 				},
-				ClientOrderID: stringPtr("0x06c60000000000000000000000003f5a"),
+				ClientOrderID: cloidPtr("0x06c60000000000000000000000003f5a"),
 			},
 			result: OrderStatus{
 				Resting: &OrderStatusResting{
 					Oid:      37543130760,
-					ClientID: stringPtr("0x06c60000000000000000000000003f5a"),
+					ClientID: cloidPtr("0x06c60000000000000000000000003f5a"),
 				},
 			},
 			record: false,
@@ -0,0 +1,175 @@
+package hyperliquid
+
+import (
+	"fmt"
+)
+
+type (
+	// TpslLeg is a take profit or stop loss attached to an entry or position.
+	// LimitPx is the price of the order once triggered and defaults to
+	// TriggerPx. Market legs still use it as their worst acceptable price.
+	TpslLeg struct {
+		TriggerPx     float64
+		LimitPx       float64
+		IsMarket      bool
+		ClientOrderID *Cloid
+	}
+
+	// BracketOrderRequest is an entry order with optional TP/SL legs. The legs
+	// close the entry size and only go live once the entry fills.
+	BracketOrderRequest struct {
+		Entry      CreateOrderRequest
+		TakeProfit *TpslLeg
+		StopLoss   *TpslLeg
+	}
+
+	// BracketOrderResult holds the status of each leg that was sent
+	BracketOrderResult struct {
+		Entry      OrderStatus
+		TakeProfit *OrderStatus
+		StopLoss   *OrderStatus
+	}
+
+	// PositionTpslResult holds the status of each leg that was sent
+	PositionTpslResult struct {
+		TakeProfit *OrderStatus
+		StopLoss   *OrderStatus
+	}
+)
+
+func (l TpslLeg) order(coin string, isBuy bool, size float64, tpsl string) CreateOrderRequest {
+	limitPx := l.LimitPx
+	if limitPx == 0 {
+		limitPx = l.TriggerPx
+	}
+
+	return CreateOrderRequest{
+		Coin:       coin,
+		IsBuy:      isBuy,
+		Price:      limitPx,
+		Size:       size,
+		ReduceOnly: true,
+		OrderType: OrderType{
+			Trigger: &TriggerOrderType{
+				TriggerPx: l.TriggerPx,
+				IsMarket:  l.IsMarket,
+				Tpsl:      tpsl,
+			},
+		},
+		ClientOrderID: l.ClientOrderID,
+	}
+}
+
+// tpslOrders builds the closing trigger orders for the given legs
+func tpslOrders(coin string, isBuy bool, size float64, tp, sl *TpslLeg) []CreateOrderRequest {
+	var orders []CreateOrderRequest
+	if tp != nil {
+		orders = append(orders, tp.order(coin, isBuy, size, "tp"))
+	}
+	if sl != nil {
+		orders = append(orders, sl.order(coin, isBuy, size, "sl"))
+	}
+	return orders
+}
+
+// splitTpslStatuses maps the statuses of the trigger legs back to tp and sl
+func splitTpslStatuses(statuses []OrderStatus, tp, sl *TpslLeg) (tpStatus, slStatus *OrderStatus) {
+	next := func() *OrderStatus {
+		if len(statuses) == 0 {
+			return nil
+		}
+		status := statuses[0]
+		statuses = statuses[1:]
+		return &status
+	}
+
+	if tp != nil {
+		tpStatus = next()
+	}
+	if sl != nil {
+		slStatus = next()
+	}
+	return
+}
+
+// BracketOrder places an entry order together with its TP/SL legs in one
+// normalTpsl action. The result is returned alongside the error when a leg is
+// rejected, so the statuses of the other legs can be inspected.
+func (e *Exchange) BracketOrder(
+	req BracketOrderRequest,
+	builder *BuilderInfo,
+) (result BracketOrderResult, err error) {
+	if req.TakeProfit == nil && req.StopLoss == nil {
+		return result, fmt.Errorf("bracket order needs a take profit or a stop loss")
+	}
+
+	entry := req.Entry
+	orders := append(
+		[]CreateOrderRequest{entry},
+		tpslOrders(entry.Coin, !entry.IsBuy, entry.Size, req.TakeProfit, req.StopLoss)...,
+	)
+
+	resp, err := e.groupedOrders(orders, GroupingNormalTpsl, builder)
+	if resp == nil {
+		return result, err
+	}
+	if !resp.Ok {
+		return result, fmt.Errorf("failed to create bracket order: %s", resp.Err)
+	}
+
+	statuses := resp.Data.Statuses
+	if len(statuses) == 0 {
+		return result, fmt.Errorf("no status for bracket order")
+	}
+
+	result.Entry = statuses[0]
+	result.TakeProfit, result.StopLoss = splitTpslStatuses(statuses[1:], req.TakeProfit, req.StopLoss)
+	return result, err
+}
+
+// PositionTpsl attaches TP/SL orders to the current position in coin using
+// the positionTpsl grouping. The legs close the whole position and follow its
+// size as it changes.
+func (e *Exchange) PositionTpsl(
+	coin string,
+	tp, sl *TpslLeg,
+	builder *BuilderInfo,
+) (result PositionTpslResult, err error) {
+	if tp == nil && sl == nil {
+		return result, fmt.Errorf("position tpsl needs a take profit or a stop loss")
+	}
+
+	address := e.accountAddr
+	if address == "" {
+		address = e.vault
+	}
+
+	userState, err := e.info.UserState(address)
+	if err != nil {
+		return result, err
+	}
+
+	var szi float64
+	for _, assetPos := range userState.AssetPositions {
+		if assetPos.Position.Coin == coin {
+			szi = parseFloat(assetPos.Position.Szi)
+			break
+		}
+	}
+	if szi == 0 {
+		return result, fmt.Errorf("position not found for coin: %s", coin)
+	}
+
+	orders := tpslOrders(coin, szi < 0, abs(szi), tp, sl)
+
+	resp, err := e.groupedOrders(orders, GroupingPositionTpsl, builder)
+	if resp == nil {
+		return result, err
+	}
+	if !resp.Ok {
+		return result, fmt.Errorf("failed to create position tpsl: %s", resp.Err)
+	}
+
+	result.TakeProfit, result.StopLoss = splitTpslStatuses(resp.Data.Statuses, tp, sl)
+	return result, err
+}
@@ -0,0 +1,143 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExchange_BracketOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        BracketOrderRequest
+		response   string
+		wantAction string
+		want       BracketOrderResult
+		wantErr    string
+	}{
+		{
+			name: "entry with tp and sl",
+			req: BracketOrderRequest{
+				Entry: CreateOrderRequest{
+					Coin:      "ETH",
+					IsBuy:     true,
+					Price:     4000,
+					Size:      0.5,
+					OrderType: OrderType{Limit: &LimitOrderType{Tif: TifGtc}},
+				},
+				TakeProfit: &TpslLeg{TriggerPx: 4400, IsMarket: true},
+				StopLoss:   &TpslLeg{TriggerPx: 3800, LimitPx: 3780},
+			},
+			response: `{"status":"ok","response":{"type":"order","data":{"statuses":[{"resting":{"oid":101}},"waitingForFill","waitingForFill"]}}}`,
+			wantAction: `{"type":"order","grouping":"normalTpsl","orders":[` +
+				`{"a":1,"b":true,"p":"4000","s":"0.5","r":false,"t":{"limit":{"tif":"Gtc"}}},` +
+				`{"a":1,"b":false,"p":"4400","s":"0.5","r":true,"t":{"trigger":{"isMarket":true,"tpsl":"tp","triggerPx":"4400"}}},` +
+				`{"a":1,"b":false,"p":"3780","s":"0.5","r":true,"t":{"trigger":{"isMarket":false,"tpsl":"sl","triggerPx":"3800"}}}]}`,
+			want: BracketOrderResult{
+				Entry:      OrderStatus{Resting: &OrderStatusResting{Oid: 101}},
+				TakeProfit: &OrderStatus{Waiting: OrderStatusWaitingForFill},
+				StopLoss:   &OrderStatus{Waiting: OrderStatusWaitingForFill},
+			},
+		},
+		{
+			name: "rejected stop loss",
+			req: BracketOrderRequest{
+				Entry: CreateOrderRequest{
+					Coin:      "BTC",
+					IsBuy:     false,
+					Price:     100000,
+					Size:      0.01,
+					OrderType: OrderType{Limit: &LimitOrderType{Tif: TifIoc}},
+				},
+				StopLoss: &TpslLeg{TriggerPx: 90000, IsMarket: true},
+			},
+			response: `{"status":"ok","response":{"type":"order","data":{"statuses":[{"filled":{"totalSz":"0.01","avgPx":"100010.0","oid":102}},{"error":"Invalid TP/SL price."}]}}}`,
+			wantAction: `{"type":"order","grouping":"normalTpsl","orders":[` +
+				`{"a":0,"b":false,"p":"100000","s":"0.01","r":false,"t":{"limit":{"tif":"Ioc"}}},` +
+				`{"a":0,"b":true,"p":"90000","s":"0.01","r":true,"t":{"trigger":{"isMarket":true,"tpsl":"sl","triggerPx":"90000"}}}]}`,
+			want: BracketOrderResult{
+				Entry:    OrderStatus{Filled: &OrderStatusFilled{TotalSz: "0.01", AvgPx: "100010.0", Oid: 102}},
+				StopLoss: &OrderStatus{Error: stringPtr("Invalid TP/SL price.")},
+			},
+			wantErr: "Invalid TP/SL price.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := &memoryTransport{
+				exchangeResponse: func(map[string]any) ([]byte, error) {
+					return []byte(tc.response), nil
+				},
+			}
+			exchange := newMemoryExchange(t, transport)
+
+			got, err := exchange.BracketOrder(tc.req, nil)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.want, got)
+
+			require.Len(t, transport.exchangePayloads, 1)
+			var payload map[string]any
+			require.NoError(t, json.Unmarshal(transport.exchangePayloads[0], &payload))
+			assert.JSONEq(t, tc.wantAction, string(mustMarshal(t, payload["action"])))
+		})
+	}
+}
+
+func TestExchange_BracketOrderWithoutLegs(t *testing.T) {
+	exchange := newMemoryExchange(t, &memoryTransport{})
+
+	_, err := exchange.BracketOrder(BracketOrderRequest{
+		Entry: CreateOrderRequest{Coin: "ETH", Price: 4000, Size: 1},
+	}, nil)
+	require.Error(t, err)
+}
+
+func TestExchange_PositionTpsl(t *testing.T) {
+	transport := &memoryTransport{
+		infoResponse: func(req map[string]any) ([]byte, error) {
+			assert.Equal(t, "clearinghouseState", req["type"])
+			return []byte(`{"assetPositions":[{"type":"oneWay","position":{"coin":"ETH","szi":"-0.25"}}]}`), nil
+		},
+		exchangeResponse: func(map[string]any) ([]byte, error) {
+			return []byte(`{"status":"ok","response":{"type":"order","data":{"statuses":["waitingForTrigger"]}}}`), nil
+		},
+	}
+	exchange := newMemoryExchange(t, transport)
+
+	got, err := exchange.PositionTpsl("ETH", nil, &TpslLeg{TriggerPx: 4200, IsMarket: true}, nil)
+	require.NoError(t, err)
+	assert.Nil(t, got.TakeProfit)
+	require.NotNil(t, got.StopLoss)
+	assert.Equal(t, OrderStatusWaitingForTrigger, got.StopLoss.Waiting)
+
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal(transport.exchangePayloads[0], &payload))
+	assert.JSONEq(
+		t,
+		`{"type":"order","grouping":"positionTpsl","orders":[`+
+			`{"a":1,"b":true,"p":"4200","s":"0.25","r":true,"t":{"trigger":{"isMarket":true,"tpsl":"sl","triggerPx":"4200"}}}]}`,
+		string(mustMarshal(t, payload["action"])),
+	)
+
+	_, err = exchange.PositionTpsl("BTC", &TpslLeg{TriggerPx: 1}, nil, nil)
+	assert.EqualError(t, err, "position not found for coin: BTC")
+}
+
+func TestOrderStatus_JSON(t *testing.T) {
+	var statuses []OrderStatus
+	require.NoError(t, json.Unmarshal(
+		[]byte(`[{"resting":{"oid":1,"cloid":null,"status":""}},"waitingForFill"]`),
+		&statuses,
+	))
+	require.Len(t, statuses, 2)
+	assert.Equal(t, OrderStatusWaitingForFill, statuses[1].Waiting)
+	assert.Equal(t, `"waitingForFill"`, statuses[1].String())
+	assert.Equal(t, `{"resting":{"oid":1,"cloid":null,"status":""}}`, statuses[0].String())
+}
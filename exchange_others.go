@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
-	"time"
 
 	"github.com/ethereum/go-ethereum/crypto"
 )
@@ -20,9 +19,9 @@ func (e *Exchange) UpdateLeverage(leverage int, name string, isCross bool) (*Use
 	action := UpdateLeverageAction{
 		Type:  "updateLeverage",
 		Asset: e.info.NameToAsset(name),
-		Leverage: map[string]any{
-			"type":  leverageType,
-			"value": leverage,
+		Leverage: LeverageWire{
+			Type:  leverageType,
+			Value: leverage,
 		},
 	}
 
@@ -69,7 +68,7 @@ func (e *Exchange) SlippagePrice(
 		price = *px
 	} else {
 		// Get midprice
-		mids, err := e.info.AllMids()
+		mids, err := e.info.AllMidsForDex(perpDexOf(coin))
 		if err != nil {
 			return 0, err
 		}
@@ -81,7 +80,7 @@ func (e *Exchange) SlippagePrice(
 	}
 
 	asset := e.info.coinToAsset[coin]
-	isSpot := asset >= 10000
+	isSpot := isSpotAsset(asset)
 
 	// Calculate slippage
 	if isBuy {
@@ -102,7 +101,7 @@ func (e *Exchange) SlippagePrice(
 
 // ScheduleCancel schedules cancellation of all open orders
 func (e *Exchange) ScheduleCancel(scheduleTime *int64) (*ScheduleCancelResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := ScheduleCancelAction{
 		Type: "scheduleCancel",
@@ -135,7 +134,7 @@ func (e *Exchange) ScheduleCancel(scheduleTime *int64) (*ScheduleCancelResponse,
 
 // SetReferrer sets a referral code
 func (e *Exchange) SetReferrer(code string) (*SetReferrerResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := SetReferrerAction{
 		Type: "setReferrer",
@@ -168,7 +167,7 @@ func (e *Exchange) SetReferrer(code string) (*SetReferrerResponse, error) {
 
 // CreateSubAccount creates a new sub-account
 func (e *Exchange) CreateSubAccount(name string) (*CreateSubAccountResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := CreateSubAccountAction{
 		Type: "createSubAccount",
@@ -201,7 +200,7 @@ func (e *Exchange) CreateSubAccount(name string) (*CreateSubAccountResponse, err
 
 // UsdClassTransfer transfers between USD classes
 func (e *Exchange) UsdClassTransfer(amount float64, toPerp bool) (*TransferResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	strAmount := formatFloat(amount)
 	if e.vault != "" {
@@ -215,25 +214,8 @@ func (e *Exchange) UsdClassTransfer(amount float64, toPerp bool) (*TransferRespo
 		Nonce:  timestamp,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		timestamp,
-		e.expiresAfter,
-		e.client.baseURL == MainnetAPIURL,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := e.postAction(action, sig, timestamp)
-	if err != nil {
-		return nil, err
-	}
-
 	var result TransferResponse
-	if err := json.Unmarshal(resp, &result); err != nil {
+	if err := e.executeAction(action, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -245,7 +227,7 @@ func (e *Exchange) SubAccountTransfer(
 	isDeposit bool,
 	usd int,
 ) (*TransferResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := SubAccountTransferAction{
 		Type:           "subAccountTransfer",
@@ -284,7 +266,7 @@ func (e *Exchange) VaultUsdTransfer(
 	isDeposit bool,
 	usd int,
 ) (*TransferResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := VaultUsdTransferAction{
 		Type:         "vaultTransfer",
@@ -323,7 +305,7 @@ func (e *Exchange) CreateVault(
 	description string,
 	initialUsd int,
 ) (*CreateVaultResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := CreateVaultAction{
 		Type:        "createVault",
@@ -361,7 +343,7 @@ func (e *Exchange) VaultModify(
 	allowDeposits bool,
 	alwaysCloseOnWithdraw bool,
 ) (*TransferResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := VaultModifyAction{
 		Type:                  "vaultModify",
@@ -395,7 +377,7 @@ func (e *Exchange) VaultModify(
 }
 
 func (e *Exchange) VaultDistribute(vaultAddress string, usd int) (*TransferResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := VaultDistributeAction{
 		Type:         "vaultDistribute",
@@ -429,7 +411,7 @@ func (e *Exchange) VaultDistribute(vaultAddress string, usd int) (*TransferRespo
 
 // UsdTransfer transfers USD to another address
 func (e *Exchange) UsdTransfer(amount float64, destination string) (*TransferResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := UsdTransferAction{
 		Type:        "usdSend",
@@ -438,64 +420,31 @@ func (e *Exchange) UsdTransfer(amount float64, destination string) (*TransferRes
 		Time:        timestamp,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		timestamp,
-		e.expiresAfter,
-		e.client.baseURL == MainnetAPIURL,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := e.postAction(action, sig, timestamp)
-	if err != nil {
-		return nil, err
-	}
-
 	var result TransferResponse
-	if err := json.Unmarshal(resp, &result); err != nil {
+	if err := e.executeAction(action, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-// SpotTransfer transfers spot tokens to another address
+// SpotTransfer transfers spot tokens to another address. token may be a
+// symbol ("PURR") or already in the "PURR:0x..." format.
 func (e *Exchange) SpotTransfer(
 	amount float64,
 	destination, token string,
 ) (*TransferResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := SpotTransferAction{
 		Type:        "spotSend",
 		Destination: destination,
 		Amount:      formatFloat(amount),
-		Token:       token,
+		Token:       e.info.spotToken(token),
 		Time:        timestamp,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		timestamp,
-		e.expiresAfter,
-		e.client.baseURL == MainnetAPIURL,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := e.postAction(action, sig, timestamp)
-	if err != nil {
-		return nil, err
-	}
-
 	var result TransferResponse
-	if err := json.Unmarshal(resp, &result); err != nil {
+	if err := e.executeAction(action, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -503,7 +452,7 @@ func (e *Exchange) SpotTransfer(
 
 // UseBigBlocks enables or disables big blocks
 func (e *Exchange) UseBigBlocks(enable bool) (*ApprovalResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := UseBigBlocksAction{
 		Type:           "evmUserModify",
@@ -540,7 +489,7 @@ func (e *Exchange) PerpDexClassTransfer(
 	amount float64,
 	toPerp bool,
 ) (*TransferResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := PerpDexClassTransferAction{
 		Type:   "perpDexClassTransfer",
@@ -574,20 +523,21 @@ func (e *Exchange) PerpDexClassTransfer(
 	return &result, nil
 }
 
-// SubAccountSpotTransfer transfers spot tokens to/from sub-account
+// SubAccountSpotTransfer transfers spot tokens to/from sub-account. token is
+// resolved like in SpotTransfer.
 func (e *Exchange) SubAccountSpotTransfer(
 	subAccountUser string,
 	isDeposit bool,
 	token string,
 	amount float64,
 ) (*TransferResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := SubAccountSpotTransferAction{
 		Type:           "subAccountSpotTransfer",
 		SubAccountUser: subAccountUser,
 		IsDeposit:      isDeposit,
-		Token:          token,
+		Token:          e.info.spotToken(token),
 		Amount:         amount,
 	}
 
@@ -621,7 +571,7 @@ func (e *Exchange) TokenDelegate(
 	wei int,
 	isUndelegate bool,
 ) (*TransferResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := TokenDelegateAction{
 		Type:         "tokenDelegate",
@@ -631,25 +581,8 @@ func (e *Exchange) TokenDelegate(
 		Nonce:        timestamp,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		timestamp,
-		e.expiresAfter,
-		e.client.baseURL == MainnetAPIURL,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := e.postAction(action, sig, timestamp)
-	if err != nil {
-		return nil, err
-	}
-
 	var result TransferResponse
-	if err := json.Unmarshal(resp, &result); err != nil {
+	if err := e.executeAction(action, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -660,7 +593,7 @@ func (e *Exchange) WithdrawFromBridge(
 	amount float64,
 	destination string,
 ) (*TransferResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := WithdrawFromBridgeAction{
 		Type:        "withdraw3",
@@ -669,25 +602,8 @@ func (e *Exchange) WithdrawFromBridge(
 		Time:        timestamp,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		timestamp,
-		e.expiresAfter,
-		e.client.baseURL == MainnetAPIURL,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := e.postAction(action, sig, timestamp)
-	if err != nil {
-		return nil, err
-	}
-
 	var result TransferResponse
-	if err := json.Unmarshal(resp, &result); err != nil {
+	if err := e.executeAction(action, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -708,8 +624,16 @@ func (e *Exchange) ApproveAgent(name *string) (*AgentApprovalResponse, string, e
 		return nil, "", fmt.Errorf("failed to create private key: %w", err)
 	}
 
-	agentAddress := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
-	timestamp := time.Now().UnixMilli()
+	result, err := e.approveAgent(crypto.PubkeyToAddress(privateKey.PublicKey).Hex(), name)
+	if err != nil {
+		return nil, "", err
+	}
+	return result, agentKey, nil
+}
+
+// approveAgent approves the agent with the given address
+func (e *Exchange) approveAgent(agentAddress string, name *string) (*AgentApprovalResponse, error) {
+	timestamp := e.nextNonce()
 
 	action := ApproveAgentAction{
 		Type:         "approveAgent",
@@ -718,33 +642,16 @@ func (e *Exchange) ApproveAgent(name *string) (*AgentApprovalResponse, string, e
 		Nonce:        timestamp,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		timestamp,
-		e.expiresAfter,
-		e.client.baseURL == MainnetAPIURL,
-	)
-	if err != nil {
-		return nil, "", err
-	}
-
-	resp, err := e.postAction(action, sig, timestamp)
-	if err != nil {
-		return nil, "", err
-	}
-
 	var result AgentApprovalResponse
-	if err := json.Unmarshal(resp, &result); err != nil {
-		return nil, "", err
+	if err := e.executeAction(action, &result); err != nil {
+		return nil, err
 	}
-	return &result, agentKey, nil
+	return &result, nil
 }
 
 // ApproveBuilderFee approves builder fee payment
 func (e *Exchange) ApproveBuilderFee(builder string, maxFeeRate string) (*ApprovalResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := ApproveBuilderFeeAction{
 		Type:       "approveBuilderFee",
@@ -753,25 +660,8 @@ func (e *Exchange) ApproveBuilderFee(builder string, maxFeeRate string) (*Approv
 		Nonce:      timestamp,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		timestamp,
-		e.expiresAfter,
-		e.client.baseURL == MainnetAPIURL,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := e.postAction(action, sig, timestamp)
-	if err != nil {
-		return nil, err
-	}
-
 	var result ApprovalResponse
-	if err := json.Unmarshal(resp, &result); err != nil {
+	if err := e.executeAction(action, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -782,7 +672,7 @@ func (e *Exchange) ConvertToMultiSigUser(
 	authorizedUsers []string,
 	threshold int,
 ) (*MultiSigConversionResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	// Sort users as done in Python
 	sort.Strings(authorizedUsers)
@@ -803,25 +693,8 @@ func (e *Exchange) ConvertToMultiSigUser(
 		Nonce:   timestamp,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		timestamp,
-		e.expiresAfter,
-		e.client.baseURL == MainnetAPIURL,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := e.postAction(action, sig, timestamp)
-	if err != nil {
-		return nil, err
-	}
-
 	var result MultiSigConversionResponse
-	if err := json.Unmarshal(resp, &result); err != nil {
+	if err := e.executeAction(action, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -837,7 +710,7 @@ func (e *Exchange) SpotDeployRegisterToken(
 	maxGas int,
 	fullName string,
 ) (*SpotDeployResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := map[string]any{
 		"type": "spotDeploy",
@@ -878,7 +751,7 @@ func (e *Exchange) SpotDeployRegisterToken(
 
 // SpotDeployUserGenesis initializes user genesis for spot trading
 func (e *Exchange) SpotDeployUserGenesis(balances map[string]float64) (*SpotDeployResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := map[string]any{
 		"type":     "spotDeployUserGenesis",
@@ -911,7 +784,7 @@ func (e *Exchange) SpotDeployUserGenesis(balances map[string]float64) (*SpotDepl
 
 // SpotDeployEnableFreezePrivilege enables freeze privilege for spot deployer
 func (e *Exchange) SpotDeployEnableFreezePrivilege() (*SpotDeployResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := map[string]any{
 		"type": "spotDeployEnableFreezePrivilege",
@@ -943,7 +816,7 @@ func (e *Exchange) SpotDeployEnableFreezePrivilege() (*SpotDeployResponse, error
 
 // SpotDeployFreezeUser freezes a user in spot trading
 func (e *Exchange) SpotDeployFreezeUser(userAddress string) (*SpotDeployResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := map[string]any{
 		"type":        "spotDeployFreezeUser",
@@ -976,7 +849,7 @@ func (e *Exchange) SpotDeployFreezeUser(userAddress string) (*SpotDeployResponse
 
 // SpotDeployRevokeFreezePrivilege revokes freeze privilege for spot deployer
 func (e *Exchange) SpotDeployRevokeFreezePrivilege() (*SpotDeployResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := map[string]any{
 		"type": "spotDeployRevokeFreezePrivilege",
@@ -1008,7 +881,7 @@ func (e *Exchange) SpotDeployRevokeFreezePrivilege() (*SpotDeployResponse, error
 
 // SpotDeployGenesis initializes spot genesis
 func (e *Exchange) SpotDeployGenesis(deployer string, dexName string) (*SpotDeployResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := map[string]any{
 		"type":     "spotDeployGenesis",
@@ -1045,7 +918,7 @@ func (e *Exchange) SpotDeployRegisterSpot(
 	baseToken string,
 	quoteToken string,
 ) (*SpotDeployResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := map[string]any{
 		"type":       "spotDeployRegisterSpot",
@@ -1082,7 +955,7 @@ func (e *Exchange) SpotDeployRegisterHyperliquidity(
 	name string,
 	tokens []string,
 ) (*SpotDeployResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := map[string]any{
 		"type":   "spotDeployRegisterHyperliquidity",
@@ -1118,7 +991,7 @@ func (e *Exchange) SpotDeployRegisterHyperliquidity(
 func (e *Exchange) SpotDeploySetDeployerTradingFeeShare(
 	feeShare float64,
 ) (*SpotDeployResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := map[string]any{
 		"type":     "spotDeploySetDeployerTradingFeeShare",
@@ -1156,7 +1029,7 @@ func (e *Exchange) PerpDeployRegisterAsset(
 	asset string,
 	perpDexInput PerpDexSchemaInput,
 ) (*PerpDeployResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := map[string]any{
 		"type":         "perpDeployRegisterAsset",
@@ -1193,7 +1066,7 @@ func (e *Exchange) PerpDeploySetOracle(
 	asset string,
 	oracleAddress string,
 ) (*SpotDeployResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := map[string]any{
 		"type":          "perpDeploySetOracle",
@@ -1229,7 +1102,7 @@ func (e *Exchange) PerpDeploySetOracle(
 
 // CSignerUnjailSelf unjails self as consensus signer
 func (e *Exchange) CSignerUnjailSelf() (*ValidatorResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := map[string]any{
 		"type": "cSignerUnjailSelf",
@@ -1261,7 +1134,7 @@ func (e *Exchange) CSignerUnjailSelf() (*ValidatorResponse, error) {
 
 // CSignerJailSelf jails self as consensus signer
 func (e *Exchange) CSignerJailSelf() (*ValidatorResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := map[string]any{
 		"type": "cSignerJailSelf",
@@ -1293,7 +1166,7 @@ func (e *Exchange) CSignerJailSelf() (*ValidatorResponse, error) {
 
 // CSignerInner executes inner consensus signer action
 func (e *Exchange) CSignerInner(innerAction map[string]any) (*ValidatorResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := map[string]any{
 		"type":        "cSignerInner",
@@ -1328,7 +1201,7 @@ func (e *Exchange) CSignerInner(innerAction map[string]any) (*ValidatorResponse,
 
 // CValidatorRegister registers as consensus validator
 func (e *Exchange) CValidatorRegister(validatorProfile map[string]any) (*ValidatorResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := map[string]any{
 		"type":             "cValidatorRegister",
@@ -1361,7 +1234,7 @@ func (e *Exchange) CValidatorRegister(validatorProfile map[string]any) (*Validat
 
 // CValidatorChangeProfile changes validator profile
 func (e *Exchange) CValidatorChangeProfile(newProfile map[string]any) (*ValidatorResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := map[string]any{
 		"type":       "cValidatorChangeProfile",
@@ -1394,7 +1267,7 @@ func (e *Exchange) CValidatorChangeProfile(newProfile map[string]any) (*Validato
 
 // CValidatorUnregister unregisters as consensus validator
 func (e *Exchange) CValidatorUnregister() (*ValidatorResponse, error) {
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.nextNonce()
 
 	action := map[string]any{
 		"type": "cValidatorUnregister",
@@ -1423,41 +1296,3 @@ func (e *Exchange) CValidatorUnregister() (*ValidatorResponse, error) {
 	}
 	return &result, nil
 }
-
-func (e *Exchange) MultiSig(
-	action map[string]any,
-	signers []string,
-	signatures []string,
-) (*MultiSigResponse, error) {
-	timestamp := time.Now().UnixMilli()
-
-	multiSigAction := map[string]any{
-		"type":       "multiSig",
-		"action":     action,
-		"signers":    signers,
-		"signatures": signatures,
-	}
-
-	sig, err := SignL1Action(
-		e.privateKey,
-		multiSigAction,
-		e.vault,
-		timestamp,
-		e.expiresAfter,
-		e.client.baseURL == MainnetAPIURL,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := e.postAction(multiSigAction, sig, timestamp)
-	if err != nil {
-		return nil, err
-	}
-
-	var result MultiSigResponse
-	if err := json.Unmarshal(resp, &result); err != nil {
-		return nil, err
-	}
-	return &result, nil
-}
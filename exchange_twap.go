@@ -0,0 +1,130 @@
+package hyperliquid
+
+import (
+	"fmt"
+)
+
+type (
+	TwapOrderRequest struct {
+		Coin       string
+		IsBuy      bool
+		Size       float64
+		Minutes    int
+		Randomize  bool
+		ReduceOnly bool
+	}
+
+	TwapOrderResponse struct {
+		Status TwapOrderStatus `json:"status"`
+	}
+
+	TwapOrderStatus struct {
+		Running *TwapRunning `json:"running,omitempty"`
+		Error   *string      `json:"error,omitempty"`
+	}
+
+	TwapRunning struct {
+		TwapID int64 `json:"twapId"`
+	}
+
+	TwapCancelResponse struct {
+		Status MixedValue `json:"status"`
+	}
+)
+
+func newTwapOrderAction(e *Exchange, req TwapOrderRequest) (TwapOrderAction, error) {
+	sizeWire, err := floatToWire(req.Size)
+	if err != nil {
+		return TwapOrderAction{}, fmt.Errorf("failed to wire size: %w", err)
+	}
+
+	asset, err := e.info.AssetByName(req.Coin)
+	if err != nil {
+		return TwapOrderAction{}, err
+	}
+
+	return TwapOrderAction{
+		Type: "twapOrder",
+		Twap: TwapWire{
+			Asset:      asset,
+			IsBuy:      req.IsBuy,
+			Size:       sizeWire,
+			ReduceOnly: req.ReduceOnly,
+			Minutes:    req.Minutes,
+			Randomize:  req.Randomize,
+		},
+	}, nil
+}
+
+// TwapOrder starts a TWAP order that executes size over the given minutes.
+// The returned status holds the twap id used to follow and cancel it.
+func (e *Exchange) TwapOrder(
+	coin string,
+	isBuy bool,
+	size float64,
+	minutes int,
+	randomize, reduceOnly bool,
+) (res *APIResponse[TwapOrderResponse], err error) {
+	action, err := newTwapOrderAction(e, TwapOrderRequest{
+		Coin:       coin,
+		IsBuy:      isBuy,
+		Size:       size,
+		Minutes:    minutes,
+		Randomize:  randomize,
+		ReduceOnly: reduceOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = e.executeAction(action, &res); err != nil {
+		return
+	}
+
+	if res == nil || !res.Ok {
+		if res != nil && res.Err != "" {
+			return res, fmt.Errorf("%s", res.Err)
+		}
+		return res, fmt.Errorf("twap order failed")
+	}
+
+	if res.Data.Status.Error != nil {
+		return res, fmt.Errorf("%s", *res.Data.Status.Error)
+	}
+
+	return
+}
+
+// TwapCancel cancels a running TWAP order
+func (e *Exchange) TwapCancel(
+	coin string,
+	twapID int64,
+) (res *APIResponse[TwapCancelResponse], err error) {
+	asset, err := e.info.AssetByName(coin)
+	if err != nil {
+		return nil, err
+	}
+
+	action := TwapCancelAction{
+		Type:   "twapCancel",
+		Asset:  asset,
+		TwapID: twapID,
+	}
+
+	if err = e.executeAction(action, &res); err != nil {
+		return
+	}
+
+	if res == nil || !res.Ok {
+		if res != nil && res.Err != "" {
+			return res, fmt.Errorf("%s", res.Err)
+		}
+		return res, fmt.Errorf("twap cancel failed")
+	}
+
+	if err := (MixedArray{res.Data.Status}).FirstError(); err != nil {
+		return res, err
+	}
+
+	return
+}
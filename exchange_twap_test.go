@@ -0,0 +1,120 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExchange_TwapOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		response   string
+		wantAction string
+		wantID     int64
+		wantErr    string
+	}{
+		{
+			name:       "running",
+			response:   `{"status":"ok","response":{"type":"twapOrder","data":{"status":{"running":{"twapId":77738308}}}}}`,
+			wantAction: `{"type":"twapOrder","twap":{"a":1,"b":true,"s":"0.1","r":false,"m":30,"t":true}}`,
+			wantID:     77738308,
+		},
+		{
+			name:       "rejected",
+			response:   `{"status":"ok","response":{"type":"twapOrder","data":{"status":{"error":"Invalid TWAP duration: 1 min(s)"}}}}`,
+			wantAction: `{"type":"twapOrder","twap":{"a":1,"b":true,"s":"0.1","r":false,"m":30,"t":true}}`,
+			wantErr:    "Invalid TWAP duration: 1 min(s)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := &memoryTransport{
+				exchangeResponse: func(map[string]any) ([]byte, error) {
+					return []byte(tc.response), nil
+				},
+			}
+			exchange := newMemoryExchange(t, transport)
+
+			res, err := exchange.TwapOrder("ETH", true, 0.1, 30, true, false)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, res.Data.Status.Running)
+				assert.Equal(t, tc.wantID, res.Data.Status.Running.TwapID)
+			}
+
+			require.Len(t, transport.exchangePayloads, 1)
+			var payload map[string]any
+			require.NoError(t, json.Unmarshal(transport.exchangePayloads[0], &payload))
+			assert.JSONEq(t, tc.wantAction, string(mustMarshal(t, payload["action"])))
+		})
+	}
+}
+
+func TestExchange_TwapCancel(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		wantErr  string
+	}{
+		{
+			name:     "success",
+			response: `{"status":"ok","response":{"type":"twapCancel","data":{"status":"success"}}}`,
+		},
+		{
+			name:     "error",
+			response: `{"status":"ok","response":{"type":"twapCancel","data":{"status":{"error":"TWAP was never placed, already canceled, or filled."}}}}`,
+			wantErr:  "TWAP was never placed, already canceled, or filled.",
+		},
+		{
+			name:     "rejected",
+			response: `{"status":"err","response":"User or API Wallet does not exist."}`,
+			wantErr:  "User or API Wallet does not exist.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := &memoryTransport{
+				exchangeResponse: func(map[string]any) ([]byte, error) {
+					return []byte(tc.response), nil
+				},
+			}
+			exchange := newMemoryExchange(t, transport)
+
+			_, err := exchange.TwapCancel("BTC", 3156)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			var payload map[string]any
+			require.NoError(t, json.Unmarshal(transport.exchangePayloads[0], &payload))
+			assert.JSONEq(
+				t,
+				`{"type":"twapCancel","a":0,"t":3156}`,
+				string(mustMarshal(t, payload["action"])),
+			)
+		})
+	}
+}
+
+func TestExchange_TwapUnknownCoin(t *testing.T) {
+	transport := &memoryTransport{}
+	exchange := newMemoryExchange(t, transport)
+
+	// A perp dex that was not loaded must not trade asset 0 (BTC)
+	_, err := exchange.TwapOrder("test:ABC", true, 0.1, 30, true, false)
+	assert.EqualError(t, err, `unknown coin "test:ABC"`)
+
+	_, err = exchange.TwapCancel("test:ABC", 3156)
+	assert.EqualError(t, err, `unknown coin "test:ABC"`)
+
+	assert.Empty(t, transport.exchangePayloads)
+}
@@ -0,0 +1,194 @@
+package hltest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	hl "github.com/sonirico/go-hyperliquid"
+)
+
+type exchangeRequest struct {
+	Action       json.RawMessage    `json:"action"`
+	Nonce        int64              `json:"nonce"`
+	Signature    hl.SignatureResult `json:"signature"`
+	VaultAddress *string            `json:"vaultAddress"`
+	ExpiresAfter *int64             `json:"expiresAfter"`
+}
+
+func (s *Server) handleExchange(rw http.ResponseWriter, r *http.Request) {
+	if s.drop(rw) || !s.delay(r.Context()) {
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := s.exchange(r.Context(), body)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	writeJSON(rw, resp)
+}
+
+func errResponse(format string, args ...any) ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"status":   "err",
+		"response": fmt.Sprintf(format, args...),
+	})
+}
+
+// exchange verifies and executes a signed action, as sent to /exchange or in
+// a websocket post
+func (s *Server) exchange(ctx context.Context, body []byte) ([]byte, error) {
+	var req exchangeRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, fmt.Errorf("invalid exchange request: %w", err)
+	}
+
+	var header struct {
+		Type             string `json:"type"`
+		SignatureChainID string `json:"signatureChainId"`
+	}
+	if err := json.Unmarshal(req.Action, &header); err != nil {
+		return nil, fmt.Errorf("invalid action: %w", err)
+	}
+
+	var vault string
+	if req.VaultAddress != nil {
+		vault = *req.VaultAddress
+	}
+
+	action, err := decodeAction(header.Type, req.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	// The type decides how an action is signed, like on the exchange
+	userSigned := hl.IsUserSignedAction(header.Type)
+	if userSigned && header.SignatureChainID == "" {
+		return errResponse("%s must carry a user signature", header.Type)
+	}
+	signer, err := s.recoverSigner(req, action, vault, userSigned)
+	if err != nil {
+		return errResponse("%v", err)
+	}
+	signer = strings.ToLower(signer)
+
+	s.mu.Lock()
+	owner := signer
+	if master, ok := s.agents[signer]; ok && !userSigned {
+		owner = master
+	}
+	if _, ok := s.accounts[owner]; !ok {
+		s.mu.Unlock()
+		return errResponse("User or API Wallet %s does not exist.", signer)
+	}
+
+	account := owner
+	if vault != "" {
+		account = strings.ToLower(vault)
+		if _, ok := s.accounts[account]; !ok {
+			s.mu.Unlock()
+			return errResponse("Vault not registered: %s", account)
+		}
+	}
+	paper := s.accounts[account]
+
+	used, ok := s.nonces[signer]
+	if !ok {
+		used = make(map[int64]struct{})
+		s.nonces[signer] = used
+	}
+	if _, dup := used[req.Nonce]; dup {
+		s.mu.Unlock()
+		return errResponse("Invalid nonce: duplicate nonce %d for %s", req.Nonce, signer)
+	}
+	used[req.Nonce] = struct{}{}
+
+	s.actions = append(s.actions, Action{
+		Type:    header.Type,
+		Signer:  signer,
+		Account: account,
+		Nonce:   req.Nonce,
+		Raw:     append([]byte(nil), req.Action...),
+	})
+
+	for i, rejection := range s.rejections {
+		if rejection.actionType == "" || rejection.actionType == header.Type {
+			s.rejections = append(s.rejections[:i], s.rejections[i+1:]...)
+			s.mu.Unlock()
+			return errResponse("%s", rejection.message)
+		}
+	}
+	s.mu.Unlock()
+
+	return paper.Exchange(ctx, map[string]any{"action": action})
+}
+
+// recoverSigner returns the address that signed the action. Actions decoded
+// into their typed form are hashed as such, others as they were sent. User
+// signed actions can only be signed by the account itself.
+func (s *Server) recoverSigner(
+	req exchangeRequest,
+	action any,
+	vault string,
+	userSigned bool,
+) (string, error) {
+	if !userSigned {
+		signed := action
+		if _, generic := action.(map[string]any); generic {
+			signed = hl.RawAction(req.Action)
+		}
+		return hl.RecoverL1Signer(
+			signed,
+			vault,
+			req.Nonce,
+			req.ExpiresAfter,
+			req.Signature,
+			s.config.Mainnet,
+		)
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(req.Action, &fields); err != nil {
+		return "", fmt.Errorf("invalid action: %w", err)
+	}
+	return hl.RecoverUserSignedActionSigner(fields, req.Signature)
+}
+
+// decodeAction decodes the actions the paper engine executes into their
+// typed form. Other actions are left as maps and answered with an error.
+func decodeAction(typ string, raw json.RawMessage) (any, error) {
+	switch typ {
+	case "order":
+		return decodeAs[hl.OrderAction](typ, raw)
+	case "modify":
+		return decodeAs[hl.ModifyAction](typ, raw)
+	case "batchModify":
+		return decodeAs[hl.BatchModifyAction](typ, raw)
+	case "cancel":
+		return decodeAs[hl.CancelAction](typ, raw)
+	case "cancelByCloid":
+		return decodeAs[hl.CancelByCloidAction](typ, raw)
+	case "updateLeverage":
+		return decodeAs[hl.UpdateLeverageAction](typ, raw)
+	default:
+		return decodeAs[map[string]any](typ, raw)
+	}
+}
+
+func decodeAs[T any](typ string, raw json.RawMessage) (any, error) {
+	var action T
+	if err := json.Unmarshal(raw, &action); err != nil {
+		return nil, fmt.Errorf("invalid %s action: %w", typ, err)
+	}
+	return action, nil
+}
@@ -0,0 +1,121 @@
+package hltest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+var errNoExchange = errors.New("hltest: the market transport does not take actions")
+
+// userQueries are the info queries answered from the account state
+var userQueries = map[string]bool{
+	"clearinghouseState": true,
+	"openOrders":         true,
+	"frontendOpenOrders": true,
+	"userFills":          true,
+}
+
+func formatFloat(x float64) string {
+	return strconv.FormatFloat(x, 'f', -1, 64)
+}
+
+func (s *Server) handleInfo(rw http.ResponseWriter, r *http.Request) {
+	if s.drop(rw) || !s.delay(r.Context()) {
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var request map[string]any
+	if err := json.Unmarshal(body, &request); err != nil {
+		http.Error(rw, "invalid info request", http.StatusUnprocessableEntity)
+		return
+	}
+
+	resp, err := s.info(r.Context(), request)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	writeJSON(rw, resp)
+}
+
+// info answers an info request, as sent to /info or in a websocket post
+func (s *Server) info(ctx context.Context, request map[string]any) ([]byte, error) {
+	typ, _ := request["type"].(string)
+	if !userQueries[typ] {
+		return s.marketInfo(request)
+	}
+
+	user, _ := request["user"].(string)
+	if user == "" {
+		return nil, fmt.Errorf("%s needs a user", typ)
+	}
+
+	s.mu.Lock()
+	account, ok := s.accounts[strings.ToLower(user)]
+	if !ok {
+		// Unknown users have an empty state, like on the real API
+		account = s.addAccount(user, 0)
+	}
+	s.mu.Unlock()
+
+	return account.Info(ctx, request)
+}
+
+// marketInfo answers the queries that do not depend on an account
+func (s *Server) marketInfo(request map[string]any) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch request["type"] {
+	case "meta":
+		return json.Marshal(s.metaResponse())
+	case "spotMeta":
+		return json.Marshal(s.config.SpotMeta)
+	case "allMids":
+		return json.Marshal(s.mids())
+	case "l2Book":
+		coin, _ := request["coin"].(string)
+		book, ok := s.books[coin]
+		if !ok {
+			return []byte("null"), nil
+		}
+		return json.Marshal(book)
+	case "userFees":
+		return json.Marshal(s.config.Fees)
+	default:
+		return nil, fmt.Errorf("hltest: unsupported info request %v", request["type"])
+	}
+}
+
+// metaResponse renders meta with margin tables as [id, table] tuples like
+// the API does
+func (s *Server) metaResponse() map[string]any {
+	tables := make([][]any, len(s.config.Meta.MarginTables))
+	for i, table := range s.config.Meta.MarginTables {
+		tables[i] = []any{table.ID, map[string]any{
+			"description": table.Description,
+			"marginTiers": table.MarginTiers,
+		}}
+	}
+	return map[string]any{
+		"universe":     s.config.Meta.Universe,
+		"marginTables": tables,
+	}
+}
+
+func writeJSON(rw http.ResponseWriter, body []byte) {
+	rw.Header().Set("Content-Type", "application/json")
+	_, _ = rw.Write(body)
+}
@@ -0,0 +1,296 @@
+// Package hltest provides an in-process fake of the Hyperliquid API for
+// integration tests. It serves /info, /exchange and /ws on an httptest server,
+// keeps configurable order books, accounts and positions, verifies action
+// signatures by recovering the signer, and lets tests script rejections,
+// latency and disconnects.
+//
+// Orders are matched by the paper trading engine of the SDK: they fill
+// against the configured books without consuming them, and resting orders
+// fill once the mid crosses them.
+package hltest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	hl "github.com/sonirico/go-hyperliquid"
+)
+
+// Config configures a Server
+type Config struct {
+	// Meta is the perp universe. Defaults to BTC and ETH.
+	Meta *hl.Meta
+	// SpotMeta is the spot universe. Defaults to an empty one.
+	SpotMeta *hl.SpotMeta
+	// Fees are the fee rates of every account. Defaults to zero fees.
+	Fees *hl.UserFees
+	// Mainnet makes the server expect mainnet signatures. Clients pointed at
+	// a test server sign for testnet.
+	Mainnet bool
+}
+
+// Action is an action the server accepted the signature of
+type Action struct {
+	Type    string
+	Signer  string
+	Account string
+	Nonce   int64
+	Raw     []byte
+}
+
+type rejection struct {
+	actionType string
+	message    string
+}
+
+// Server is a fake Hyperliquid API. Create it with NewServer and point the
+// SDK at URL.
+type Server struct {
+	srv    *httptest.Server
+	config Config
+
+	mu         sync.Mutex
+	books      map[string]hl.L2Book
+	accounts   map[string]*hl.PaperTransport
+	agents     map[string]string
+	nonces     map[string]map[int64]struct{}
+	actions    []Action
+	rejections []rejection
+	latency    time.Duration
+	drops      int
+	conns      map[*wsConn]struct{}
+}
+
+func NewServer(config Config) *Server {
+	if config.Meta == nil {
+		config.Meta = &hl.Meta{
+			Universe: []hl.AssetInfo{
+				{Name: "BTC", SzDecimals: 5, MaxLeverage: 40},
+				{Name: "ETH", SzDecimals: 4, MaxLeverage: 25},
+			},
+		}
+	}
+	if config.SpotMeta == nil {
+		config.SpotMeta = &hl.SpotMeta{}
+	}
+	if config.Fees == nil {
+		config.Fees = &hl.UserFees{UserCrossRate: "0", UserAddRate: "0"}
+	}
+
+	s := &Server{
+		config:   config,
+		books:    make(map[string]hl.L2Book),
+		accounts: make(map[string]*hl.PaperTransport),
+		agents:   make(map[string]string),
+		nonces:   make(map[string]map[int64]struct{}),
+		conns:    make(map[*wsConn]struct{}),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/info", s.handleInfo)
+	mux.HandleFunc("/exchange", s.handleExchange)
+	mux.HandleFunc("/ws", s.handleWS)
+	s.srv = httptest.NewServer(mux)
+	return s
+}
+
+// URL is the base URL to pass to NewExchange, NewInfo or NewWebsocketClient
+func (s *Server) URL() string {
+	return s.srv.URL
+}
+
+func (s *Server) Close() {
+	s.DisconnectWebsockets()
+	s.srv.Close()
+}
+
+// SetBook replaces the book of coin and pushes it, along with the new mids, to
+// websocket subscribers. Bids are sorted best first, as are asks.
+func (s *Server) SetBook(coin string, bids, asks []hl.Level) {
+	book := hl.L2Book{
+		Coin:   coin,
+		Levels: [][]hl.Level{bids, asks},
+		Time:   time.Now().UnixMilli(),
+	}
+
+	s.mu.Lock()
+	s.books[coin] = book
+	mids := s.mids()
+	s.mu.Unlock()
+
+	s.publish(hl.ChannelL2Book, coin, book)
+	s.publish(hl.ChannelAllMids, "", hl.AllMids{Mids: mids})
+}
+
+// SetMid replaces the book of coin with a single level of size sz one tick of
+// tick away from mid on each side
+func (s *Server) SetMid(coin string, mid, tick, sz float64) {
+	s.SetBook(
+		coin,
+		[]hl.Level{{Px: mid - tick, Sz: sz, N: 1}},
+		[]hl.Level{{Px: mid + tick, Sz: sz, N: 1}},
+	)
+}
+
+// AddAccount creates an account with a USDC balance. Actions signed by
+// unknown addresses are rejected.
+func (s *Server) AddAccount(address string, balance float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.addAccount(address, balance)
+}
+
+func (s *Server) addAccount(address string, balance float64) *hl.PaperTransport {
+	info := hl.NewInfo(s.srv.URL, true, s.config.Meta, s.config.SpotMeta)
+	account := hl.NewPaperTransport(marketTransport{s}, info, hl.PaperConfig{
+		User:    address,
+		Balance: balance,
+		Fees:    s.config.Fees,
+	})
+	s.accounts[strings.ToLower(address)] = account
+	return account
+}
+
+// SetPosition gives the account of address a position in coin, adding the
+// account with a zero balance if it does not exist. A zero leverage value
+// keeps the default cross leverage.
+func (s *Server) SetPosition(address, coin string, szi, entryPx float64, leverage hl.Leverage) {
+	s.mu.Lock()
+	account, ok := s.accounts[strings.ToLower(address)]
+	if !ok {
+		account = s.addAccount(address, 0)
+	}
+	s.mu.Unlock()
+
+	account.SetPosition(coin, szi, entryPx, leverage)
+}
+
+// ApproveAgent lets agent sign actions for master
+func (s *Server) ApproveAgent(master, agent string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.agents[strings.ToLower(agent)] = strings.ToLower(master)
+}
+
+// RejectNext makes the next action of the given type, or of any type when
+// actionType is empty, fail with message once its signature was verified
+func (s *Server) RejectNext(actionType, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rejections = append(s.rejections, rejection{actionType: actionType, message: message})
+}
+
+// SetLatency delays every /info and /exchange response, and websocket post
+// responses
+func (s *Server) SetLatency(latency time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = latency
+}
+
+// DropNext closes the connection of the next n HTTP requests without
+// answering them
+func (s *Server) DropNext(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.drops += n
+}
+
+// DisconnectWebsockets closes every websocket connection
+func (s *Server) DisconnectWebsockets() {
+	s.mu.Lock()
+	conns := make([]*wsConn, 0, len(s.conns))
+	for conn := range s.conns {
+		conns = append(conns, conn)
+	}
+	s.mu.Unlock()
+
+	for _, conn := range conns {
+		_ = conn.close()
+	}
+}
+
+// Actions returns the actions the server accepted the signature of, oldest
+// first
+func (s *Server) Actions() []Action {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Action(nil), s.actions...)
+}
+
+// mids returns the mid of every book with both sides
+func (s *Server) mids() map[string]string {
+	mids := make(map[string]string, len(s.books))
+	for coin, book := range s.books {
+		if len(book.Levels) != 2 || len(book.Levels[0]) == 0 || len(book.Levels[1]) == 0 {
+			continue
+		}
+		mid := (book.Levels[0][0].Px + book.Levels[1][0].Px) / 2
+		mids[coin] = formatFloat(mid)
+	}
+	return mids
+}
+
+// delay applies the scripted latency and reports whether the request should
+// still be answered
+func (s *Server) delay(ctx context.Context) bool {
+	s.mu.Lock()
+	latency := s.latency
+	s.mu.Unlock()
+
+	if latency == 0 {
+		return true
+	}
+	select {
+	case <-time.After(latency):
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// drop hijacks and closes the connection if a drop was scripted
+func (s *Server) drop(rw http.ResponseWriter) bool {
+	s.mu.Lock()
+	if s.drops == 0 {
+		s.mu.Unlock()
+		return false
+	}
+	s.drops--
+	s.mu.Unlock()
+
+	hijacker, ok := rw.(http.Hijacker)
+	if !ok {
+		http.Error(rw, "connection dropped", http.StatusServiceUnavailable)
+		return true
+	}
+	conn, _, err := hijacker.Hijack()
+	if err == nil {
+		_ = conn.Close()
+	}
+	return true
+}
+
+// marketTransport answers the market data queries of the paper engine from
+// the configured books
+type marketTransport struct {
+	s *Server
+}
+
+func (t marketTransport) Info(_ context.Context, request any) ([]byte, error) {
+	return t.s.marketInfo(request.(map[string]any))
+}
+
+func (t marketTransport) Exchange(context.Context, any) ([]byte, error) {
+	return nil, errNoExchange
+}
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(*http.Request) bool { return true },
+}
@@ -0,0 +1,305 @@
+package hltest_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	hl "github.com/sonirico/go-hyperliquid"
+	"github.com/sonirico/go-hyperliquid/hltest"
+)
+
+func newKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return key, crypto.PubkeyToAddress(key.PublicKey).Hex()
+}
+
+func newServer(t *testing.T) *hltest.Server {
+	t.Helper()
+	srv := hltest.NewServer(hltest.Config{})
+	t.Cleanup(srv.Close)
+	srv.SetMid("ETH", 4000, 1, 5)
+	return srv
+}
+
+func ioc(coin string, isBuy bool, px, sz float64) hl.CreateOrderRequest {
+	return hl.CreateOrderRequest{
+		Coin:      coin,
+		IsBuy:     isBuy,
+		Price:     px,
+		Size:      sz,
+		OrderType: hl.OrderType{Limit: &hl.LimitOrderType{Tif: hl.TifIoc}},
+	}
+}
+
+func TestServer_Order(t *testing.T) {
+	srv := newServer(t)
+	key, addr := newKey(t)
+	srv.AddAccount(addr, 10000)
+
+	exchange := hl.NewExchange(key, srv.URL(), nil, "", addr, nil)
+	status, err := exchange.Order(ioc("ETH", true, 4010, 1), nil)
+	require.NoError(t, err)
+	require.NotNil(t, status.Filled)
+	assert.Equal(t, "4001", status.Filled.AvgPx)
+
+	info := hl.NewInfo(srv.URL(), true, nil, nil)
+	state, err := info.UserState(addr)
+	require.NoError(t, err)
+	require.Len(t, state.AssetPositions, 1)
+	assert.Equal(t, "1", state.AssetPositions[0].Position.Szi)
+
+	actions := srv.Actions()
+	require.Len(t, actions, 1)
+	assert.Equal(t, "order", actions[0].Type)
+	assert.Equal(t, strings.ToLower(addr), actions[0].Signer)
+	assert.Equal(t, strings.ToLower(addr), actions[0].Account)
+}
+
+func TestServer_SetPosition(t *testing.T) {
+	srv := newServer(t)
+	key, addr := newKey(t)
+	srv.AddAccount(addr, 10000)
+	srv.SetPosition(addr, "ETH", -2, 3900, hl.Leverage{Type: "isolated", Value: 5})
+
+	info := hl.NewInfo(srv.URL(), true, nil, nil)
+	state, err := info.UserState(addr)
+	require.NoError(t, err)
+	require.Len(t, state.AssetPositions, 1)
+	position := state.AssetPositions[0].Position
+	assert.Equal(t, "-2", position.Szi)
+	assert.Equal(t, "3900", *position.EntryPx)
+	assert.Equal(t, "-200", position.UnrealizedPnl)
+	assert.Equal(t, hl.Leverage{Type: "isolated", Value: 5}, position.Leverage)
+
+	// The position trades like one opened with orders
+	exchange := hl.NewExchange(key, srv.URL(), nil, "", addr, nil)
+	order := ioc("ETH", true, 4010, 2)
+	order.ReduceOnly = true
+	status, err := exchange.Order(order, nil)
+	require.NoError(t, err)
+	require.NotNil(t, status.Filled)
+
+	state, err = info.UserState(addr)
+	require.NoError(t, err)
+	assert.Empty(t, state.AssetPositions)
+}
+
+func TestServer_VerifiesSignatures(t *testing.T) {
+	srv := newServer(t)
+	key, addr := newKey(t)
+	srv.AddAccount(addr, 10000)
+	exchange := hl.NewExchange(key, srv.URL(), nil, "", addr, nil)
+
+	cloid := hl.CloidFor(7, 1)
+	orders, err := exchange.BulkOrders([]hl.CreateOrderRequest{
+		{
+			Coin: "ETH", IsBuy: true, Price: 3900.5, Size: 0.5, ClientOrderID: &cloid,
+			OrderType: hl.OrderType{Limit: &hl.LimitOrderType{Tif: hl.TifGtc}},
+		},
+		{
+			Coin: "ETH", IsBuy: false, Price: 3500, Size: 0.5, ReduceOnly: true,
+			OrderType: hl.OrderType{Trigger: &hl.TriggerOrderType{TriggerPx: 3600, IsMarket: true, Tpsl: "sl"}},
+		},
+	}, &hl.BuilderInfo{Builder: "0x8c967E73E7B15087c42A10D344cFf4c96D877f1D", Fee: 10})
+	require.NoError(t, err)
+	assert.True(t, orders.Ok)
+
+	_, err = exchange.ModifyOrder(hl.ModifyOrderRequest{
+		Oid: hl.CloidRef(cloid),
+		Order: hl.CreateOrderRequest{
+			Coin: "ETH", IsBuy: true, Price: 3901, Size: 0.5, ClientOrderID: &cloid,
+			OrderType: hl.OrderType{Limit: &hl.LimitOrderType{Tif: hl.TifGtc}},
+		},
+	})
+	require.NoError(t, err)
+
+	// UpdateLeverage does not report the status, so check it on the response
+	unsigned, err := exchange.BuildAction(hl.UpdateLeverageAction{
+		Type:     "updateLeverage",
+		Asset:    1,
+		Leverage: hl.LeverageWire{Type: "isolated", Value: 5},
+	})
+	require.NoError(t, err)
+	signed, err := exchange.SignAction(unsigned)
+	require.NoError(t, err)
+	resp, err := exchange.SubmitSignedAction(context.Background(), signed)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"status":"ok","response":{"type":"default"}}`, string(resp))
+
+	canceled, err := exchange.CancelByCloid("ETH", cloid)
+	require.NoError(t, err)
+	assert.True(t, canceled.Ok)
+
+	actions := srv.Actions()
+	require.Len(t, actions, 4)
+	for _, action := range actions {
+		assert.Equal(t, strings.ToLower(addr), action.Signer, action.Type)
+	}
+
+	other, _ := newKey(t)
+	stranger := hl.NewExchange(other, srv.URL(), nil, "", addr, nil)
+	_, err = stranger.Order(ioc("ETH", true, 4010, 1), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not exist")
+}
+
+func TestServer_UserSignedAction(t *testing.T) {
+	srv := newServer(t)
+	key, addr := newKey(t)
+	srv.AddAccount(addr, 10000)
+
+	action := map[string]any{
+		"type":        "usdSend",
+		"destination": "0x5e9ee1089755c3435139848e47e6635505d5a13a",
+		"amount":      "1",
+		"time":        int64(1),
+	}
+	signature, err := hl.SignUserSignedAction(
+		key,
+		action,
+		[]apitypes.Type{
+			{Name: "hyperliquidChain", Type: "string"},
+			{Name: "destination", Type: "string"},
+			{Name: "amount", Type: "string"},
+			{Name: "time", Type: "uint64"},
+		},
+		"HyperliquidTransaction:UsdSend",
+		false,
+	)
+	require.NoError(t, err)
+
+	_, err = hl.NewHTTPTransport(srv.URL()).Exchange(context.Background(), map[string]any{
+		"action":    action,
+		"nonce":     1,
+		"signature": signature,
+	})
+	require.NoError(t, err)
+
+	actions := srv.Actions()
+	require.Len(t, actions, 1)
+	assert.Equal(t, "usdSend", actions[0].Type)
+	assert.Equal(t, strings.ToLower(addr), actions[0].Signer)
+
+	// A transfer signed as an L1 action is rejected
+	l1 := map[string]any{
+		"type":        "usdSend",
+		"destination": "0x5e9ee1089755c3435139848e47e6635505d5a13a",
+		"amount":      "1",
+		"time":        int64(2),
+	}
+	signature, err = hl.SignL1Action(key, l1, "", 2, nil, false)
+	require.NoError(t, err)
+	resp, err := hl.NewHTTPTransport(srv.URL()).Exchange(context.Background(), map[string]any{
+		"action":    l1,
+		"nonce":     2,
+		"signature": signature,
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"status":"err","response":"usdSend must carry a user signature"}`, string(resp))
+	assert.Len(t, srv.Actions(), 1)
+}
+
+func TestServer_Agent(t *testing.T) {
+	srv := newServer(t)
+	_, master := newKey(t)
+	agentKey, agent := newKey(t)
+	srv.AddAccount(master, 10000)
+	srv.ApproveAgent(master, agent)
+
+	exchange := hl.NewExchange(agentKey, srv.URL(), nil, "", master, nil)
+	_, err := exchange.Order(ioc("ETH", false, 3990, 1), nil)
+	require.NoError(t, err)
+
+	actions := srv.Actions()
+	require.Len(t, actions, 1)
+	assert.Equal(t, strings.ToLower(agent), actions[0].Signer)
+	assert.Equal(t, strings.ToLower(master), actions[0].Account)
+
+	state, err := hl.NewInfo(srv.URL(), true, nil, nil).UserState(master)
+	require.NoError(t, err)
+	require.Len(t, state.AssetPositions, 1)
+	assert.Equal(t, "-1", state.AssetPositions[0].Position.Szi)
+}
+
+func TestServer_Scripting(t *testing.T) {
+	srv := newServer(t)
+	key, addr := newKey(t)
+	srv.AddAccount(addr, 10000)
+	exchange := hl.NewExchange(key, srv.URL(), nil, "", addr, nil)
+
+	srv.RejectNext("order", "Too many cumulative requests sent")
+	_, err := exchange.Order(ioc("ETH", true, 4010, 1), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Too many cumulative requests sent")
+
+	_, err = exchange.Order(ioc("ETH", true, 4010, 1), nil)
+	require.NoError(t, err)
+
+	transport := hl.NewHTTPTransport(srv.URL())
+
+	srv.DropNext(1)
+	_, err = transport.Info(context.Background(), map[string]any{"type": "allMids"})
+	require.Error(t, err)
+	_, err = transport.Info(context.Background(), map[string]any{"type": "allMids"})
+	require.NoError(t, err)
+
+	srv.SetLatency(200 * time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = transport.Info(ctx, map[string]any{"type": "allMids"})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestServer_Websocket(t *testing.T) {
+	srv := newServer(t)
+	key, addr := newKey(t)
+	srv.AddAccount(addr, 10000)
+
+	ws := hl.NewWebsocketClient(srv.URL())
+	require.NoError(t, ws.Connect(context.Background()))
+	t.Cleanup(func() { _ = ws.Close() })
+
+	books := make(chan hl.L2Book, 4)
+	_, err := ws.L2Book(hl.L2BookSubscriptionParams{Coin: "ETH"}, func(book hl.L2Book, err error) {
+		require.NoError(t, err)
+		books <- book
+	})
+	require.NoError(t, err)
+
+	nextBook := func() hl.L2Book {
+		select {
+		case book := <-books:
+			return book
+		case <-time.After(time.Second):
+			t.Fatal("no l2Book message")
+			return hl.L2Book{}
+		}
+	}
+	assert.Equal(t, 3999.0, nextBook().Levels[0][0].Px)
+
+	srv.SetMid("ETH", 4100, 1, 5)
+	assert.Equal(t, 4099.0, nextBook().Levels[0][0].Px)
+
+	exchange := hl.NewExchange(key, srv.URL(), nil, "", addr, nil)
+	exchange.SetTransport(hl.NewWebsocketTransport(ws))
+	status, err := exchange.Order(ioc("ETH", true, 4110, 1), nil)
+	require.NoError(t, err)
+	require.NotNil(t, status.Filled)
+	assert.Equal(t, "4101", status.Filled.AvgPx)
+
+	srv.DisconnectWebsockets()
+	require.Eventually(t, func() bool {
+		_, err := ws.PostInfo(context.Background(), map[string]any{"type": "allMids"})
+		return err != nil
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,180 @@
+package hltest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/websocket"
+
+	hl "github.com/sonirico/go-hyperliquid"
+)
+
+type wsConn struct {
+	conn *websocket.Conn
+
+	mu   sync.Mutex
+	subs map[string]bool
+}
+
+type wsCommand struct {
+	Method       string         `json:"method"`
+	ID           int64          `json:"id"`
+	Subscription map[string]any `json:"subscription"`
+	Request      struct {
+		Type    string          `json:"type"`
+		Payload json.RawMessage `json:"payload"`
+	} `json:"request"`
+}
+
+// subKey identifies a subscription by channel and coin
+func subKey(channel, coin string) string {
+	return channel + ":" + coin
+}
+
+func (c *wsConn) write(channel string, data any) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn.WriteJSON(map[string]any{"channel": channel, "data": data})
+}
+
+func (c *wsConn) subscribed(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.subs[key]
+}
+
+func (c *wsConn) setSubscribed(key string, subscribed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if subscribed {
+		c.subs[key] = true
+	} else {
+		delete(c.subs, key)
+	}
+}
+
+func (c *wsConn) close() error {
+	return c.conn.Close()
+}
+
+func (s *Server) handleWS(rw http.ResponseWriter, r *http.Request) {
+	conn, err := upgrader.Upgrade(rw, r, nil)
+	if err != nil {
+		return
+	}
+
+	c := &wsConn{conn: conn, subs: make(map[string]bool)}
+	s.mu.Lock()
+	s.conns[c] = struct{}{}
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, c)
+		s.mu.Unlock()
+		_ = c.close()
+	}()
+
+	for {
+		var cmd wsCommand
+		if err := conn.ReadJSON(&cmd); err != nil {
+			return
+		}
+
+		switch cmd.Method {
+		case "ping":
+			_ = c.write(hl.ChannelPong, nil)
+		case "subscribe", "unsubscribe":
+			s.handleSubscription(c, cmd)
+		case "post":
+			go s.handlePost(r.Context(), c, cmd)
+		}
+	}
+}
+
+func (s *Server) handleSubscription(c *wsConn, cmd wsCommand) {
+	channel, _ := cmd.Subscription["type"].(string)
+	coin, _ := cmd.Subscription["coin"].(string)
+	subscribe := cmd.Method == "subscribe"
+	c.setSubscribed(subKey(channel, coin), subscribe)
+
+	_ = c.write(hl.ChannelSubResponse, map[string]any{
+		"method":       cmd.Method,
+		"subscription": cmd.Subscription,
+	})
+	if !subscribe {
+		return
+	}
+
+	s.mu.Lock()
+	book, hasBook := s.books[coin]
+	mids := s.mids()
+	s.mu.Unlock()
+
+	switch channel {
+	case hl.ChannelL2Book:
+		if hasBook {
+			_ = c.write(channel, book)
+		}
+	case hl.ChannelAllMids:
+		_ = c.write(channel, hl.AllMids{Mids: mids})
+	}
+}
+
+func (s *Server) handlePost(ctx context.Context, c *wsConn, cmd wsCommand) {
+	if !s.delay(ctx) {
+		return
+	}
+
+	var resp map[string]any
+	switch cmd.Request.Type {
+	case "info":
+		var request map[string]any
+		err := json.Unmarshal(cmd.Request.Payload, &request)
+		var data []byte
+		if err == nil {
+			data, err = s.info(ctx, request)
+		}
+		if err != nil {
+			resp = map[string]any{"type": "error", "payload": err.Error()}
+			break
+		}
+		resp = map[string]any{
+			"type": "info",
+			"payload": map[string]any{
+				"type": request["type"],
+				"data": json.RawMessage(data),
+			},
+		}
+	case "action":
+		data, err := s.exchange(ctx, cmd.Request.Payload)
+		if err != nil {
+			resp = map[string]any{"type": "error", "payload": err.Error()}
+			break
+		}
+		resp = map[string]any{"type": "action", "payload": json.RawMessage(data)}
+	default:
+		resp = map[string]any{"type": "error", "payload": "unknown post request type " + cmd.Request.Type}
+	}
+
+	_ = c.write(hl.ChannelPost, map[string]any{"id": cmd.ID, "response": resp})
+}
+
+// publish sends data to the connections subscribed to channel and coin
+func (s *Server) publish(channel, coin string, data any) {
+	s.mu.Lock()
+	conns := make([]*wsConn, 0, len(s.conns))
+	for c := range s.conns {
+		conns = append(conns, c)
+	}
+	s.mu.Unlock()
+
+	key := subKey(channel, coin)
+	for _, c := range conns {
+		if c.subscribed(key) {
+			_ = c.write(channel, data)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package hyperliquid
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -8,13 +9,19 @@ import (
 const (
 	// spotAssetIndexOffset is the offset added to spot asset indices
 	spotAssetIndexOffset = 10000
+	// perpDexAssetIndexOffset is the offset of the first builder-deployed perp dex
+	perpDexAssetIndexOffset = 110000
+	// perpDexAssetIndexStride separates the asset ranges of consecutive perp dexes
+	perpDexAssetIndexStride = 10000
 )
 
 type Info struct {
-	client         *Client
+	transport      Transport
+	limiter        RateLimiter
 	coinToAsset    map[string]int
 	nameToCoin     map[string]string
 	assetToDecimal map[int]int
+	spot           *SpotResolver
 }
 
 // postTimeRangeRequest makes a POST request with time range parameters
@@ -23,6 +30,23 @@ func (i *Info) postTimeRangeRequest(
 	startTime int64,
 	endTime *int64,
 	extraParams map[string]any,
+) ([]byte, error) {
+	return i.postTimeRangeRequestContext(
+		context.Background(),
+		requestType,
+		user,
+		startTime,
+		endTime,
+		extraParams,
+	)
+}
+
+func (i *Info) postTimeRangeRequestContext(
+	ctx context.Context,
+	requestType, user string,
+	startTime int64,
+	endTime *int64,
+	extraParams map[string]any,
 ) ([]byte, error) {
 	payload := map[string]any{
 		"type":      requestType,
@@ -38,16 +62,46 @@ func (i *Info) postTimeRangeRequest(
 		payload[k] = v
 	}
 
-	resp, err := i.client.post("/info", payload)
+	resp, err := i.postContext(ctx, payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch %s: %w", requestType, err)
 	}
 	return resp, nil
 }
 
+// post sends an info request through the configured transport
+func (i *Info) post(payload any) ([]byte, error) {
+	return i.postContext(context.Background(), payload)
+}
+
+func (i *Info) postContext(ctx context.Context, payload any) ([]byte, error) {
+	if i.limiter != nil {
+		if err := i.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return i.transport.Info(ctx, payload)
+}
+
+// SetTransport sets the transport used for info queries
+func (i *Info) SetTransport(transport Transport) {
+	i.transport = transport
+}
+
+// RateLimiter throttles info requests. *rate.Limiter from
+// golang.org/x/time/rate satisfies it.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// SetRateLimiter makes every info request wait on limiter first
+func (i *Info) SetRateLimiter(limiter RateLimiter) {
+	i.limiter = limiter
+}
+
 func NewInfo(baseURL string, skipWS bool, meta *Meta, spotMeta *SpotMeta) *Info {
 	info := &Info{
-		client:         NewClient(baseURL),
+		transport:      NewHTTPTransport(baseURL),
 		coinToAsset:    make(map[string]int),
 		nameToCoin:     make(map[string]string),
 		assetToDecimal: make(map[int]int),
@@ -70,19 +124,10 @@ func NewInfo(baseURL string, skipWS bool, meta *Meta, spotMeta *SpotMeta) *Info
 	}
 
 	// Map perp assets
-	for asset, assetInfo := range meta.Universe {
-		info.coinToAsset[assetInfo.Name] = asset
-		info.nameToCoin[assetInfo.Name] = assetInfo.Name
-		info.assetToDecimal[asset] = assetInfo.SzDecimals
-	}
+	info.mapPerpAssets(meta, 0)
 
 	// Map spot assets starting at 10000
-	for _, spotInfo := range spotMeta.Universe {
-		asset := spotInfo.Index + spotAssetIndexOffset
-		info.coinToAsset[spotInfo.Name] = asset
-		info.nameToCoin[spotInfo.Name] = spotInfo.Name
-		info.assetToDecimal[asset] = spotMeta.Tokens[spotInfo.Tokens[0]].SzDecimals
-	}
+	info.mapSpotAssets(spotMeta)
 
 	return info
 }
@@ -140,9 +185,20 @@ func parseMetaResponse(resp []byte) (*Meta, error) {
 }
 
 func (i *Info) Meta() (*Meta, error) {
-	resp, err := i.client.post("/info", map[string]any{
+	return i.MetaForDex("")
+}
+
+// MetaForDex returns the perp universe of a perp dex. The empty string is the
+// default dex.
+func (i *Info) MetaForDex(dex string) (*Meta, error) {
+	payload := map[string]any{
 		"type": "meta",
-	})
+	}
+	if dex != "" {
+		payload["dex"] = dex
+	}
+
+	resp, err := i.post(payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch meta: %w", err)
 	}
@@ -151,7 +207,7 @@ func (i *Info) Meta() (*Meta, error) {
 }
 
 func (i *Info) SpotMeta() (*SpotMeta, error) {
-	resp, err := i.client.post("/info", map[string]any{
+	resp, err := i.post(map[string]any{
 		"type": "spotMeta",
 	})
 	if err != nil {
@@ -171,8 +227,32 @@ func (i *Info) NameToAsset(name string) int {
 	return i.coinToAsset[coin]
 }
 
+// AssetByName returns the asset of name. Unlike NameToAsset, which returns
+// the asset of BTC for unknown names, it fails for names that are not known,
+// such as "dex:COIN" on a perp dex that was not loaded.
+func (i *Info) AssetByName(name string) (int, error) {
+	coin, ok := i.nameToCoin[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown coin %q", name)
+	}
+	asset, ok := i.coinToAsset[coin]
+	if !ok {
+		return 0, fmt.Errorf("unknown coin %q", name)
+	}
+	return asset, nil
+}
+
+// coin returns the coin for name. Unknown names, such as "dex:COIN" on a perp
+// dex that was not loaded, are passed through as is.
+func (i *Info) coin(name string) string {
+	if coin, ok := i.nameToCoin[name]; ok {
+		return coin
+	}
+	return name
+}
+
 func (i *Info) UserState(address string) (*UserState, error) {
-	resp, err := i.client.post("/info", map[string]any{
+	resp, err := i.post(map[string]any{
 		"type": "clearinghouseState",
 		"user": address,
 	})
@@ -188,7 +268,7 @@ func (i *Info) UserState(address string) (*UserState, error) {
 }
 
 func (i *Info) SpotUserState(address string) (*SpotUserState, error) {
-	resp, err := i.client.post("/info", map[string]any{
+	resp, err := i.post(map[string]any{
 		"type": "spotClearinghouseState",
 		"user": address,
 	})
@@ -204,7 +284,7 @@ func (i *Info) SpotUserState(address string) (*SpotUserState, error) {
 }
 
 func (i *Info) OpenOrders(address string) ([]OpenOrder, error) {
-	resp, err := i.client.post("/info", map[string]any{
+	resp, err := i.post(map[string]any{
 		"type": "openOrders",
 		"user": address,
 	})
@@ -220,7 +300,7 @@ func (i *Info) OpenOrders(address string) ([]OpenOrder, error) {
 }
 
 func (i *Info) FrontendOpenOrders(address string) ([]OpenOrder, error) {
-	resp, err := i.client.post("/info", map[string]any{
+	resp, err := i.post(map[string]any{
 		"type": "frontendOpenOrders",
 		"user": address,
 	})
@@ -236,9 +316,20 @@ func (i *Info) FrontendOpenOrders(address string) ([]OpenOrder, error) {
 }
 
 func (i *Info) AllMids() (map[string]string, error) {
-	resp, err := i.client.post("/info", map[string]any{
+	return i.AllMidsForDex("")
+}
+
+// AllMidsForDex returns the mid prices of a perp dex. The empty string is the
+// default dex, which also includes spot.
+func (i *Info) AllMidsForDex(dex string) (map[string]string, error) {
+	payload := map[string]any{
 		"type": "allMids",
-	})
+	}
+	if dex != "" {
+		payload["dex"] = dex
+	}
+
+	resp, err := i.post(payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch all mids: %w", err)
 	}
@@ -251,7 +342,7 @@ func (i *Info) AllMids() (map[string]string, error) {
 }
 
 func (i *Info) UserFills(address string) ([]Fill, error) {
-	resp, err := i.client.post("/info", map[string]any{
+	resp, err := i.post(map[string]any{
 		"type": "userFills",
 		"user": address,
 	})
@@ -280,7 +371,7 @@ func (i *Info) UserFillsByTime(address string, startTime int64, endTime *int64)
 }
 
 func (i *Info) MetaAndAssetCtxs() (*MetaAndAssetCtxs, error) {
-	resp, err := i.client.post("/info", map[string]any{
+	resp, err := i.post(map[string]any{
 		"type": "metaAndAssetCtxs",
 	})
 	if err != nil {
@@ -325,7 +416,7 @@ func (i *Info) MetaAndAssetCtxs() (*MetaAndAssetCtxs, error) {
 }
 
 func (i *Info) SpotMetaAndAssetCtxs() (*SpotMetaAndAssetCtxs, error) {
-	resp, err := i.client.post("/info", map[string]any{
+	resp, err := i.post(map[string]any{
 		"type": "spotMetaAndAssetCtxs",
 	})
 	if err != nil {
@@ -374,7 +465,7 @@ func (i *Info) FundingHistory(
 	startTime int64,
 	endTime *int64,
 ) ([]FundingHistory, error) {
-	coin := i.nameToCoin[name]
+	coin := i.coin(name)
 	resp, err := i.postTimeRangeRequest(
 		"fundingHistory",
 		"",
@@ -411,9 +502,9 @@ func (i *Info) UserFundingHistory(
 }
 
 func (i *Info) L2Snapshot(name string) (*L2Book, error) {
-	resp, err := i.client.post("/info", map[string]any{
+	resp, err := i.post(map[string]any{
 		"type": "l2Book",
-		"coin": i.nameToCoin[name],
+		"coin": i.coin(name),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch L2 snapshot: %w", err)
@@ -428,13 +519,13 @@ func (i *Info) L2Snapshot(name string) (*L2Book, error) {
 
 func (i *Info) CandlesSnapshot(name, interval string, startTime, endTime int64) ([]Candle, error) {
 	req := map[string]any{
-		"coin":      i.nameToCoin[name],
+		"coin":      i.coin(name),
 		"interval":  interval,
 		"startTime": startTime,
 		"endTime":   endTime,
 	}
 
-	resp, err := i.client.post("/info", map[string]any{
+	resp, err := i.post(map[string]any{
 		"type": "candleSnapshot",
 		"req":  req,
 	})
@@ -450,7 +541,7 @@ func (i *Info) CandlesSnapshot(name, interval string, startTime, endTime int64)
 }
 
 func (i *Info) UserFees(address string) (*UserFees, error) {
-	resp, err := i.client.post("/info", map[string]any{
+	resp, err := i.post(map[string]any{
 		"type": "userFees",
 		"user": address,
 	})
@@ -466,7 +557,7 @@ func (i *Info) UserFees(address string) (*UserFees, error) {
 }
 
 func (i *Info) UserActiveAssetData(address string, coin string) (*UserActiveAssetData, error) {
-	resp, err := i.client.post("/info", map[string]any{
+	resp, err := i.post(map[string]any{
 		"type": "activeAssetData",
 		"user": address,
 		"coin": coin,
@@ -483,7 +574,7 @@ func (i *Info) UserActiveAssetData(address string, coin string) (*UserActiveAsse
 }
 
 func (i *Info) UserStakingSummary(address string) (*StakingSummary, error) {
-	resp, err := i.client.post("/info", map[string]any{
+	resp, err := i.post(map[string]any{
 		"type": "delegatorSummary",
 		"user": address,
 	})
@@ -499,7 +590,7 @@ func (i *Info) UserStakingSummary(address string) (*StakingSummary, error) {
 }
 
 func (i *Info) UserStakingDelegations(address string) ([]StakingDelegation, error) {
-	resp, err := i.client.post("/info", map[string]any{
+	resp, err := i.post(map[string]any{
 		"type": "delegations",
 		"user": address,
 	})
@@ -515,7 +606,7 @@ func (i *Info) UserStakingDelegations(address string) ([]StakingDelegation, erro
 }
 
 func (i *Info) UserStakingRewards(address string) ([]StakingReward, error) {
-	resp, err := i.client.post("/info", map[string]any{
+	resp, err := i.post(map[string]any{
 		"type": "delegatorRewards",
 		"user": address,
 	})
@@ -530,11 +621,16 @@ func (i *Info) UserStakingRewards(address string) ([]StakingReward, error) {
 	return result, nil
 }
 
-func (i *Info) QueryOrderByOid(user string, oid int64) (*OrderQueryResult, error) {
-	resp, err := i.client.post("/info", map[string]any{
+// QueryOrder returns the status of the order ref refers to
+func (i *Info) QueryOrder(user string, ref OrderRef) (*OrderQueryResult, error) {
+	if ref.IsZero() {
+		return nil, errEmptyOrderRef
+	}
+
+	resp, err := i.post(map[string]any{
 		"type": "orderStatus",
 		"user": user,
-		"oid":  oid,
+		"oid":  ref,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch order status: %w", err)
@@ -547,25 +643,16 @@ func (i *Info) QueryOrderByOid(user string, oid int64) (*OrderQueryResult, error
 	return &result, nil
 }
 
-func (i *Info) QueryOrderByCloid(user, cloid string) (*OrderQueryResult, error) {
-	resp, err := i.client.post("/info", map[string]any{
-		"type": "orderStatus",
-		"user": user,
-		"oid":  cloid,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch order status by cloid: %w", err)
-	}
+func (i *Info) QueryOrderByOid(user string, oid int64) (*OrderQueryResult, error) {
+	return i.QueryOrder(user, OidRef(oid))
+}
 
-	var result OrderQueryResult
-	if err := json.Unmarshal(resp, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal order status: %w", err)
-	}
-	return &result, nil
+func (i *Info) QueryOrderByCloid(user string, cloid Cloid) (*OrderQueryResult, error) {
+	return i.QueryOrder(user, CloidRef(cloid))
 }
 
 func (i *Info) QueryReferralState(user string) (*ReferralState, error) {
-	resp, err := i.client.post("/info", map[string]any{
+	resp, err := i.post(map[string]any{
 		"type": "referral",
 		"user": user,
 	})
@@ -581,7 +668,7 @@ func (i *Info) QueryReferralState(user string) (*ReferralState, error) {
 }
 
 func (i *Info) QuerySubAccounts(user string) ([]SubAccount, error) {
-	resp, err := i.client.post("/info", map[string]any{
+	resp, err := i.post(map[string]any{
 		"type": "subAccounts",
 		"user": user,
 	})
@@ -596,8 +683,10 @@ func (i *Info) QuerySubAccounts(user string) ([]SubAccount, error) {
 	return result, nil
 }
 
-func (i *Info) QueryUserToMultiSigSigners(multiSigUser string) ([]MultiSigSigner, error) {
-	resp, err := i.client.post("/info", map[string]any{
+// QueryUserToMultiSigSigners returns the signers of a multi-sig user, or nil
+// if the user is not a multi-sig user
+func (i *Info) QueryUserToMultiSigSigners(multiSigUser string) (*MultiSigSigners, error) {
+	resp, err := i.post(map[string]any{
 		"type": "userToMultiSigSigners",
 		"user": multiSigUser,
 	})
@@ -605,25 +694,262 @@ func (i *Info) QueryUserToMultiSigSigners(multiSigUser string) ([]MultiSigSigner
 		return nil, fmt.Errorf("failed to fetch multi-sig signers: %w", err)
 	}
 
-	var result []MultiSigSigner
+	var result *MultiSigSigners
 	if err := json.Unmarshal(resp, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal multi-sig signers: %w", err)
 	}
 	return result, nil
 }
 
-// PerpDexs returns the list of available perpetual dexes
-func (i *Info) PerpDexs() ([]string, error) {
-	resp, err := i.client.post("/info", map[string]any{
+// PerpDexs returns the available perpetual dexes. The first entry is nil and
+// stands for the default dex.
+func (i *Info) PerpDexs() ([]*PerpDex, error) {
+	resp, err := i.post(map[string]any{
 		"type": "perpDexs",
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch perp dexs: %w", err)
 	}
 
-	var result []string
+	var result []*PerpDex
 	if err := json.Unmarshal(resp, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal perp dexs: %w", err)
 	}
 	return result, nil
 }
+
+// UserNonFundingLedgerUpdates returns deposits, withdrawals, transfers, liquidations and vault
+// operations for the given user in the time range.
+func (i *Info) UserNonFundingLedgerUpdates(
+	user string,
+	startTime int64,
+	endTime *int64,
+) ([]LedgerUpdate, error) {
+	resp, err := i.postTimeRangeRequest(
+		"userNonFundingLedgerUpdates",
+		user,
+		startTime,
+		endTime,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []LedgerUpdate
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal user non-funding ledger updates: %w", err)
+	}
+	return result, nil
+}
+
+// Portfolio returns the user's account value and PnL history for each period.
+func (i *Info) Portfolio(user string) (Portfolio, error) {
+	resp, err := i.post(map[string]any{
+		"type": "portfolio",
+		"user": user,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch portfolio: %w", err)
+	}
+
+	var result Portfolio
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal portfolio: %w", err)
+	}
+	return result, nil
+}
+
+// UserTwapSliceFills returns the most recent fills produced by the user's TWAP slices.
+func (i *Info) UserTwapSliceFills(user string) ([]TwapSliceFill, error) {
+	resp, err := i.post(map[string]any{
+		"type": "userTwapSliceFills",
+		"user": user,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user twap slice fills: %w", err)
+	}
+
+	var result []TwapSliceFill
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal user twap slice fills: %w", err)
+	}
+	return result, nil
+}
+
+// PerpsAtOpenInterestCap returns the perps whose open interest is at its cap
+func (i *Info) PerpsAtOpenInterestCap() ([]string, error) {
+	resp, err := i.post(map[string]any{
+		"type": "perpsAtOpenInterestCap",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch perps at open interest cap: %w", err)
+	}
+
+	var result []string
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal perps at open interest cap: %w", err)
+	}
+	return result, nil
+}
+
+// TwapHistory returns the status transitions of the user's TWAP orders
+func (i *Info) TwapHistory(user string) ([]TwapHistory, error) {
+	resp, err := i.post(map[string]any{
+		"type": "twapHistory",
+		"user": user,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch twap history: %w", err)
+	}
+
+	var result []TwapHistory
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal twap history: %w", err)
+	}
+	return result, nil
+}
+
+// HistoricalOrders returns the user's most recent orders, up to 2000, with their final status.
+func (i *Info) HistoricalOrders(user string) ([]OrderQueryResponse, error) {
+	resp, err := i.post(map[string]any{
+		"type": "historicalOrders",
+		"user": user,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch historical orders: %w", err)
+	}
+
+	var result []OrderQueryResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal historical orders: %w", err)
+	}
+	return result, nil
+}
+
+// UserRateLimit returns the user's request budget.
+func (i *Info) UserRateLimit(user string) (*UserRateLimit, error) {
+	resp, err := i.post(map[string]any{
+		"type": "userRateLimit",
+		"user": user,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user rate limit: %w", err)
+	}
+
+	var result UserRateLimit
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal user rate limit: %w", err)
+	}
+	return &result, nil
+}
+
+// UserRole returns whether the address is a user, agent, vault or sub-account.
+func (i *Info) UserRole(user string) (*UserRole, error) {
+	resp, err := i.post(map[string]any{
+		"type": "userRole",
+		"user": user,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user role: %w", err)
+	}
+
+	var result UserRole
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal user role: %w", err)
+	}
+	return &result, nil
+}
+
+// MaxBuilderFee returns the maximum fee, in tenths of a basis point, the user
+// approved for builder.
+func (i *Info) MaxBuilderFee(user, builder string) (int, error) {
+	resp, err := i.post(map[string]any{
+		"type":    "maxBuilderFee",
+		"user":    user,
+		"builder": builder,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch max builder fee: %w", err)
+	}
+
+	var result int
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal max builder fee: %w", err)
+	}
+	return result, nil
+}
+
+// ExtraAgents returns the named API wallets approved by the user.
+func (i *Info) ExtraAgents(user string) ([]ExtraAgent, error) {
+	resp, err := i.post(map[string]any{
+		"type": "extraAgents",
+		"user": user,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch extra agents: %w", err)
+	}
+
+	var result []ExtraAgent
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal extra agents: %w", err)
+	}
+	return result, nil
+}
+
+// VaultDetails returns the vault's state, followers and portfolio. If user is
+// not empty, FollowerState holds that user's position in the vault.
+func (i *Info) VaultDetails(vaultAddress, user string) (*VaultDetails, error) {
+	payload := map[string]any{
+		"type":         "vaultDetails",
+		"vaultAddress": vaultAddress,
+	}
+	if user != "" {
+		payload["user"] = user
+	}
+
+	resp, err := i.post(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch vault details: %w", err)
+	}
+
+	var result VaultDetails
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal vault details: %w", err)
+	}
+	return &result, nil
+}
+
+// UserVaultEquities returns the user's equity in every vault they follow.
+func (i *Info) UserVaultEquities(user string) ([]UserVaultEquity, error) {
+	resp, err := i.post(map[string]any{
+		"type": "userVaultEquities",
+		"user": user,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user vault equities: %w", err)
+	}
+
+	var result []UserVaultEquity
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal user vault equities: %w", err)
+	}
+	return result, nil
+}
+
+// LeadingVaults returns the vaults the user leads.
+func (i *Info) LeadingVaults(user string) ([]LeadingVault, error) {
+	resp, err := i.post(map[string]any{
+		"type": "leadingVaults",
+		"user": user,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch leading vaults: %w", err)
+	}
+
+	var result []LeadingVault
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal leading vaults: %w", err)
+	}
+	return result, nil
+}
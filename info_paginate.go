@@ -0,0 +1,206 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"strconv"
+)
+
+// The endpoints below cap each response (2000 fills, 500 funding entries,
+// 5000 candles). The *Iter variants walk the whole range page by page: each
+// page starts at the last timestamp of the previous one and entries already
+// yielded are skipped. A page shorter than the cap is the last one. Every
+// page goes through the rate limiter set with SetRateLimiter and iteration
+// stops at the first error, which is yielded.
+//
+// A start time cannot address entries within a millisecond, so when more
+// entries than fit in a page share a timestamp the rest cannot be fetched
+// and the walk yields an error instead of silently skipping them.
+
+const (
+	fillsPageLimit   = 2000
+	fundingPageLimit = 500
+	candlesPageLimit = 5000
+)
+
+// UserFillsByTimeIter yields every fill between startTime and endTime.
+func (i *Info) UserFillsByTimeIter(
+	ctx context.Context,
+	address string,
+	startTime int64,
+	endTime *int64,
+) iter.Seq2[Fill, error] {
+	return paginate(ctx, startTime, endTime, fillsPageLimit,
+		func(ctx context.Context, start int64) ([]Fill, error) {
+			resp, err := i.postTimeRangeRequestContext(
+				ctx, "userFillsByTime", address, start, endTime, nil)
+			if err != nil {
+				return nil, err
+			}
+			var result []Fill
+			if err := json.Unmarshal(resp, &result); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal user fills by time: %w", err)
+			}
+			return result, nil
+		},
+		func(f Fill) (int64, string) {
+			return f.Time, strconv.FormatInt(f.Tid, 10)
+		},
+	)
+}
+
+// FundingHistoryIter yields every funding entry for name between startTime and endTime.
+func (i *Info) FundingHistoryIter(
+	ctx context.Context,
+	name string,
+	startTime int64,
+	endTime *int64,
+) iter.Seq2[FundingHistory, error] {
+	coin := i.coin(name)
+	return paginate(ctx, startTime, endTime, fundingPageLimit,
+		func(ctx context.Context, start int64) ([]FundingHistory, error) {
+			resp, err := i.postTimeRangeRequestContext(
+				ctx, "fundingHistory", "", start, endTime, map[string]any{"coin": coin})
+			if err != nil {
+				return nil, err
+			}
+			var result []FundingHistory
+			if err := json.Unmarshal(resp, &result); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal funding history: %w", err)
+			}
+			return result, nil
+		},
+		func(f FundingHistory) (int64, string) {
+			return f.Time, strconv.FormatInt(f.Time, 10)
+		},
+	)
+}
+
+// UserFundingHistoryIter yields every funding payment of user between startTime and endTime.
+func (i *Info) UserFundingHistoryIter(
+	ctx context.Context,
+	user string,
+	startTime int64,
+	endTime *int64,
+) iter.Seq2[UserFundingHistory, error] {
+	return paginate(ctx, startTime, endTime, fundingPageLimit,
+		func(ctx context.Context, start int64) ([]UserFundingHistory, error) {
+			resp, err := i.postTimeRangeRequestContext(ctx, "userFunding", user, start, endTime, nil)
+			if err != nil {
+				return nil, err
+			}
+			var result []UserFundingHistory
+			if err := json.Unmarshal(resp, &result); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal user funding history: %w", err)
+			}
+			return result, nil
+		},
+		func(f UserFundingHistory) (int64, string) {
+			// every coin is paid at the same funding time
+			return f.Time, strconv.FormatInt(f.Time, 10) + ":" + f.Delta.Coin
+		},
+	)
+}
+
+// CandlesSnapshotIter yields every candle for name between startTime and endTime.
+func (i *Info) CandlesSnapshotIter(
+	ctx context.Context,
+	name, interval string,
+	startTime, endTime int64,
+) iter.Seq2[Candle, error] {
+	coin := i.coin(name)
+	return paginate(ctx, startTime, &endTime, candlesPageLimit,
+		func(ctx context.Context, start int64) ([]Candle, error) {
+			resp, err := i.postContext(ctx, map[string]any{
+				"type": "candleSnapshot",
+				"req": map[string]any{
+					"coin":      coin,
+					"interval":  interval,
+					"startTime": start,
+					"endTime":   endTime,
+				},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch candles snapshot: %w", err)
+			}
+			var result []Candle
+			if err := json.Unmarshal(resp, &result); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal candles snapshot: %w", err)
+			}
+			return result, nil
+		},
+		func(c Candle) (int64, string) {
+			return c.Time, strconv.FormatInt(c.Time, 10)
+		},
+	)
+}
+
+// paginate walks a time range. fetch returns the page starting at start,
+// oldest first, with at most limit entries; cursor returns an entry's
+// timestamp and a key unique within that timestamp.
+func paginate[T any](
+	ctx context.Context,
+	startTime int64,
+	endTime *int64,
+	limit int,
+	fetch func(ctx context.Context, start int64) ([]T, error),
+	cursor func(T) (int64, string),
+) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		// seen holds the keys yielded at or after start, with their timestamp
+		seen := make(map[string]int64)
+		start := startTime
+
+		for endTime == nil || start <= *endTime {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			page, err := fetch(ctx, start)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if len(page) == 0 {
+				return
+			}
+
+			last := start
+			fresh := 0
+			for _, item := range page {
+				t, key := cursor(item)
+				if t > last {
+					last = t
+				}
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				seen[key] = t
+				fresh++
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if len(page) < limit {
+				return
+			}
+
+			if fresh == 0 {
+				// A full page of entries already yielded all share start, and
+				// those after them cannot be requested
+				yield(zero, fmt.Errorf("page saturated at timestamp %d", start))
+				return
+			}
+			start = last
+			for key, t := range seen {
+				if t < start {
+					delete(seen, key)
+				}
+			}
+		}
+	}
+}
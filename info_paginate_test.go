@@ -0,0 +1,284 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pagedInfo serves entries oldest first from startTime, at most limit per response.
+func pagedInfo(limit int, times []int64, entry func(j int, ts int64) map[string]any) *memoryTransport {
+	return &memoryTransport{
+		infoResponse: func(req map[string]any) ([]byte, error) {
+			start, end := req["startTime"], req["endTime"]
+			if r, ok := req["req"].(map[string]any); ok {
+				start, end = r["startTime"], r["endTime"]
+			}
+			var page []map[string]any
+			for j, ts := range times {
+				if float64(ts) < start.(float64) || (end != nil && float64(ts) > end.(float64)) {
+					continue
+				}
+				if len(page) == limit {
+					break
+				}
+				page = append(page, entry(j, ts))
+			}
+			return json.Marshal(page)
+		},
+	}
+}
+
+func newPagedInfo(transport Transport) *Info {
+	info := NewInfo(TestnetAPIURL, true, &Meta{Universe: []AssetInfo{{Name: "BTC"}}}, &SpotMeta{})
+	info.SetTransport(transport)
+	return info
+}
+
+type countingLimiter struct {
+	calls int
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.calls++
+	return ctx.Err()
+}
+
+func TestUserFillsByTimeIter(t *testing.T) {
+	// A full page, whose last fills share a millisecond with the first ones
+	// of the next page, then a short page that ends the walk.
+	times := make([]int64, fillsPageLimit+10)
+	for j := range times {
+		times[j] = int64(100 + j)
+	}
+	for j := fillsPageLimit - 3; j < fillsPageLimit+3; j++ {
+		times[j] = 5000
+	}
+	for j := fillsPageLimit + 3; j < len(times); j++ {
+		times[j] = int64(5000 + j)
+	}
+	transport := pagedInfo(fillsPageLimit, times, func(j int, ts int64) map[string]any {
+		return map[string]any{"coin": "BTC", "px": "1", "sz": "1", "time": ts, "tid": j + 1}
+	})
+	info := newPagedInfo(transport)
+	limiter := &countingLimiter{}
+	info.SetRateLimiter(limiter)
+
+	var tids []int64
+	for fill, err := range info.UserFillsByTimeIter(context.Background(), "0xabc", 100, nil) {
+		require.NoError(t, err)
+		tids = append(tids, fill.Tid)
+	}
+
+	require.Len(t, tids, len(times))
+	for j, tid := range tids {
+		assert.Equal(t, int64(j+1), tid)
+	}
+	require.Len(t, transport.infoRequests, 2)
+	assert.Equal(t, 2, limiter.calls)
+	assert.JSONEq(t,
+		`{"type":"userFillsByTime","user":"0xabc","startTime":100}`,
+		string(transport.infoRequests[0]))
+	assert.JSONEq(t,
+		`{"type":"userFillsByTime","user":"0xabc","startTime":5000}`,
+		string(transport.infoRequests[1]))
+}
+
+func TestUserFillsByTimeIter_RoundTrips(t *testing.T) {
+	tests := []struct {
+		name     string
+		fills    int
+		requests int
+	}{
+		{name: "none", fills: 0, requests: 1},
+		{name: "short page", fills: 10, requests: 1},
+		{name: "full page", fills: fillsPageLimit, requests: 2},
+		{name: "two pages", fills: fillsPageLimit + 1, requests: 2},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			times := make([]int64, tc.fills)
+			for j := range times {
+				times[j] = int64(j)
+			}
+			transport := pagedInfo(fillsPageLimit, times, func(j int, ts int64) map[string]any {
+				return map[string]any{"coin": "BTC", "time": ts, "tid": j + 1}
+			})
+			info := newPagedInfo(transport)
+
+			n := 0
+			for _, err := range info.UserFillsByTimeIter(context.Background(), "0xabc", 0, nil) {
+				require.NoError(t, err)
+				n++
+			}
+			assert.Equal(t, tc.fills, n)
+			assert.Len(t, transport.infoRequests, tc.requests)
+		})
+	}
+}
+
+func TestUserFillsByTimeIter_SaturatedTimestamp(t *testing.T) {
+	// More fills in one millisecond than fit in a page: the ones past the
+	// first page cannot be fetched, which is reported rather than skipped.
+	times := make([]int64, fillsPageLimit+2)
+	for j := range times {
+		times[j] = 100
+	}
+	times[len(times)-1] = 200
+	transport := pagedInfo(fillsPageLimit, times, func(j int, ts int64) map[string]any {
+		return map[string]any{"coin": "BTC", "time": ts, "tid": j + 1}
+	})
+	info := newPagedInfo(transport)
+
+	var n int
+	var iterErr error
+	for _, err := range info.UserFillsByTimeIter(context.Background(), "0xabc", 0, nil) {
+		if err != nil {
+			iterErr = err
+			break
+		}
+		n++
+	}
+	assert.EqualError(t, iterErr, "page saturated at timestamp 100")
+	assert.Equal(t, fillsPageLimit, n)
+	assert.Len(t, transport.infoRequests, 2)
+}
+
+func TestFundingHistoryIter(t *testing.T) {
+	times := make([]int64, fundingPageLimit+100)
+	for j := range times {
+		times[j] = int64(j+1) * 1000
+	}
+	transport := pagedInfo(fundingPageLimit, times, func(_ int, ts int64) map[string]any {
+		return map[string]any{"coin": "BTC", "fundingRate": "0.0001", "premium": "0", "time": ts}
+	})
+	info := newPagedInfo(transport)
+
+	end := times[len(times)-11]
+	var got []int64
+	for h, err := range info.FundingHistoryIter(context.Background(), "BTC", 1000, &end) {
+		require.NoError(t, err)
+		got = append(got, h.Time)
+	}
+	assert.Equal(t, times[:len(times)-10], got)
+	assert.Len(t, transport.infoRequests, 2)
+
+	var req map[string]any
+	require.NoError(t, json.Unmarshal(transport.infoRequests[0], &req))
+	assert.Equal(t, "BTC", req["coin"])
+}
+
+func TestUserFundingHistoryIter(t *testing.T) {
+	// Funding for two coins is paid at the same time, across a page boundary.
+	coins := []string{"BTC", "ETH", "SOL"}
+	times := make([]int64, fundingPageLimit+30)
+	for j := range times {
+		times[j] = int64(j/len(coins)+1) * 1000
+	}
+	transport := pagedInfo(fundingPageLimit, times, func(j int, ts int64) map[string]any {
+		return map[string]any{
+			"time": ts,
+			"hash": "0x0",
+			"delta": map[string]any{
+				"type":        "funding",
+				"coin":        coins[j%len(coins)],
+				"usdc":        "-0.1",
+				"szi":         "1",
+				"fundingRate": "0.0001",
+			},
+		}
+	})
+	info := newPagedInfo(transport)
+
+	n := 0
+	for _, err := range info.UserFundingHistoryIter(context.Background(), "0xabc", 0, nil) {
+		require.NoError(t, err)
+		n++
+	}
+	assert.Equal(t, len(times), n)
+	assert.Len(t, transport.infoRequests, 2)
+}
+
+func TestCandlesSnapshotIter(t *testing.T) {
+	times := make([]int64, candlesPageLimit+12)
+	for j := range times {
+		times[j] = int64(j) * 60_000
+	}
+	transport := pagedInfo(candlesPageLimit, times, func(_ int, ts int64) map[string]any {
+		return map[string]any{"t": ts, "T": ts + 59_999, "s": "BTC", "i": "1m", "o": "1", "c": "1", "h": "1", "l": "1", "v": "0", "n": 0}
+	})
+	info := newPagedInfo(transport)
+
+	end := times[len(times)-1]
+	var got []int64
+	for c, err := range info.CandlesSnapshotIter(context.Background(), "BTC", "1m", 0, end) {
+		require.NoError(t, err)
+		got = append(got, c.Time)
+	}
+	assert.Equal(t, times, got)
+	require.Len(t, transport.infoRequests, 2)
+	assert.JSONEq(t,
+		fmt.Sprintf(`{"type":"candleSnapshot","req":{"coin":"BTC","interval":"1m","startTime":%d,"endTime":%d}}`,
+			times[candlesPageLimit-1], end),
+		string(transport.infoRequests[1]))
+}
+
+func TestPaginate_Errors(t *testing.T) {
+	t.Run("fetch error", func(t *testing.T) {
+		calls := 0
+		var errs []error
+		for _, err := range paginate(context.Background(), 0, nil, 2,
+			func(context.Context, int64) ([]int64, error) {
+				calls++
+				if calls == 2 {
+					return nil, errors.New("boom")
+				}
+				return []int64{1, 2}, nil
+			},
+			func(v int64) (int64, string) { return v, fmt.Sprint(v) },
+		) {
+			errs = append(errs, err)
+		}
+		assert.Equal(t, []error{nil, nil, errors.New("boom")}, errs)
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var last error
+		n := int64(0)
+		for v, err := range paginate(ctx, 0, nil, 1,
+			func(context.Context, int64) ([]int64, error) {
+				n++
+				return []int64{n}, nil
+			},
+			func(v int64) (int64, string) { return v, fmt.Sprint(v) },
+		) {
+			if v == 3 {
+				cancel()
+			}
+			last = err
+		}
+		assert.ErrorIs(t, last, context.Canceled)
+	})
+
+	t.Run("stop early", func(t *testing.T) {
+		calls := 0
+		for range paginate(context.Background(), 0, nil, 1,
+			func(context.Context, int64) ([]int64, error) {
+				calls++
+				return []int64{int64(calls)}, nil
+			},
+			func(v int64) (int64, string) { return v, fmt.Sprint(v) },
+		) {
+			break
+		}
+		assert.Equal(t, 1, calls)
+	})
+}
@@ -0,0 +1,81 @@
+package hyperliquid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LoadPerpDexs maps the assets of builder-deployed (HIP-3) perp dexes so they
+// can be traded and queried by name, e.g. "test:ABC". With no arguments every
+// listed dex is loaded. Call it before sharing the Info between goroutines.
+//
+// Asset ids follow the dex position in PerpDexs: the n-th builder dex starts
+// at 110000 + (n-1)*10000.
+func (i *Info) LoadPerpDexs(dexes ...string) error {
+	perpDexs, err := i.PerpDexs()
+	if err != nil {
+		return err
+	}
+
+	offsets := make(map[string]int, len(perpDexs))
+	for idx, dex := range perpDexs {
+		if dex == nil || idx == 0 {
+			continue
+		}
+		offsets[dex.Name] = perpDexAssetOffset(idx)
+	}
+
+	if len(dexes) == 0 {
+		for _, dex := range perpDexs {
+			if dex != nil {
+				dexes = append(dexes, dex.Name)
+			}
+		}
+	}
+
+	for _, dex := range dexes {
+		offset, ok := offsets[dex]
+		if !ok {
+			return fmt.Errorf("unknown perp dex: %q", dex)
+		}
+		meta, err := i.MetaForDex(dex)
+		if err != nil {
+			return err
+		}
+		i.mapPerpAssets(meta, offset)
+	}
+	return nil
+}
+
+// mapPerpAssets registers a perp universe whose asset ids start at offset
+func (i *Info) mapPerpAssets(meta *Meta, offset int) {
+	for idx, assetInfo := range meta.Universe {
+		asset := idx + offset
+		i.coinToAsset[assetInfo.Name] = asset
+		i.nameToCoin[assetInfo.Name] = assetInfo.Name
+		i.assetToDecimal[asset] = assetInfo.SzDecimals
+	}
+}
+
+// perpDexAssetOffset returns the first asset id of the perp dex at position
+// idx of the perpDexs response. Position 0 is the default dex.
+func perpDexAssetOffset(idx int) int {
+	if idx == 0 {
+		return 0
+	}
+	return perpDexAssetIndexOffset + (idx-1)*perpDexAssetIndexStride
+}
+
+// isSpotAsset reports whether the asset id belongs to a spot pair
+func isSpotAsset(asset int) bool {
+	return asset >= spotAssetIndexOffset && asset < perpDexAssetIndexOffset
+}
+
+// perpDexOf returns the dex prefix of a "dex:COIN" name, or "" for the default dex
+func perpDexOf(coin string) string {
+	dex, _, found := strings.Cut(coin, ":")
+	if !found {
+		return ""
+	}
+	return dex
+}
@@ -0,0 +1,178 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPerpDexs = `[null,` +
+	`{"name":"test","fullName":"test dex","deployer":"0x5e89b26d8d66da9888c835c9bfcc2aa51813e138","oracleUpdater":null,"feeRecipient":null,"assetToStreamingOiCap":[["test:ABC","1000000.0"]]},` +
+	`{"name":"flx","fullName":"Felix Exchange","deployer":"0x2fab552502a6d45920d5741a2f3ebf4c35536352","oracleUpdater":"0x1234567890545d1df9ee64b35fdd16966e08acec","feeRecipient":null}]`
+
+func perpDexInfoResponse(t *testing.T) func(req map[string]any) ([]byte, error) {
+	return func(req map[string]any) ([]byte, error) {
+		switch req["type"] {
+		case "perpDexs":
+			return []byte(testPerpDexs), nil
+		case "meta":
+			switch req["dex"] {
+			case "test":
+				return []byte(`{"universe":[{"szDecimals":2,"name":"test:ABC","maxLeverage":10},{"szDecimals":0,"name":"test:XYZ","maxLeverage":5}],"marginTables":[]}`), nil
+			case "flx":
+				return []byte(`{"universe":[{"szDecimals":4,"name":"flx:TSLA","maxLeverage":10}],"marginTables":[]}`), nil
+			}
+		case "allMids":
+			if req["dex"] == "test" {
+				return []byte(`{"test:ABC":"12.3456","test:XYZ":"100"}`), nil
+			}
+		case "fundingHistory":
+			return []byte(`[]`), nil
+		case "l2Book":
+			return []byte(`{"coin":"test:ABC","time":1700000000000,"levels":[[],[]]}`), nil
+		}
+		t.Errorf("unexpected request: %v", req)
+		return nil, fmt.Errorf("unexpected request")
+	}
+}
+
+func TestPerpDexs(t *testing.T) {
+	transport := &memoryTransport{infoResponse: perpDexInfoResponse(t)}
+	info := NewInfo(TestnetAPIURL, true, &Meta{}, &SpotMeta{})
+	info.SetTransport(transport)
+
+	dexs, err := info.PerpDexs()
+	require.NoError(t, err)
+	require.Len(t, dexs, 3)
+	assert.Nil(t, dexs[0])
+	assert.Equal(t, "test", dexs[1].Name)
+	assert.Equal(t, "test dex", dexs[1].FullName)
+	assert.Nil(t, dexs[1].OracleUpdater)
+	assert.Equal(t, []Tuple2[string, string]{{First: "test:ABC", Second: "1000000.0"}}, dexs[1].AssetToStreamingOiCap)
+	require.NotNil(t, dexs[2].OracleUpdater)
+}
+
+func TestInfo_LoadPerpDexs(t *testing.T) {
+	tests := []struct {
+		name  string
+		dexes []string
+		want  map[string]int
+	}{
+		{
+			name:  "all dexes",
+			dexes: nil,
+			want:  map[string]int{"BTC": 0, "ETH": 1, "test:ABC": 110000, "test:XYZ": 110001, "flx:TSLA": 120000},
+		},
+		{
+			name:  "selected dex",
+			dexes: []string{"flx"},
+			want:  map[string]int{"BTC": 0, "flx:TSLA": 120000},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := &memoryTransport{infoResponse: perpDexInfoResponse(t)}
+			info := NewInfo(TestnetAPIURL, true, &Meta{Universe: []AssetInfo{
+				{Name: "BTC", SzDecimals: 5},
+				{Name: "ETH", SzDecimals: 4},
+			}}, &SpotMeta{})
+			info.SetTransport(transport)
+
+			require.NoError(t, info.LoadPerpDexs(tc.dexes...))
+			for name, asset := range tc.want {
+				assert.Equal(t, asset, info.NameToAsset(name), name)
+			}
+			if tc.dexes != nil {
+				_, ok := info.coinToAsset["test:ABC"]
+				assert.False(t, ok)
+			}
+		})
+	}
+
+	t.Run("unknown dex", func(t *testing.T) {
+		info := NewInfo(TestnetAPIURL, true, &Meta{}, &SpotMeta{})
+		info.SetTransport(&memoryTransport{infoResponse: perpDexInfoResponse(t)})
+		assert.ErrorContains(t, info.LoadPerpDexs("nope"), `unknown perp dex: "nope"`)
+	})
+}
+
+func TestInfo_PerpDexCoinQueries(t *testing.T) {
+	transport := &memoryTransport{infoResponse: perpDexInfoResponse(t)}
+	info := NewInfo(TestnetAPIURL, true, &Meta{}, &SpotMeta{})
+	info.SetTransport(transport)
+
+	// Coins of dexes that were not loaded are passed through.
+	_, err := info.FundingHistory("test:ABC", 0, nil)
+	require.NoError(t, err)
+	_, err = info.L2Snapshot("test:ABC")
+	require.NoError(t, err)
+
+	require.Len(t, transport.infoRequests, 2)
+	for _, raw := range transport.infoRequests {
+		var req map[string]any
+		require.NoError(t, json.Unmarshal(raw, &req))
+		assert.Equal(t, "test:ABC", req["coin"])
+	}
+}
+
+func TestExchange_PerpDexOrder(t *testing.T) {
+	transport := &memoryTransport{
+		infoResponse: perpDexInfoResponse(t),
+		exchangeResponse: func(map[string]any) ([]byte, error) {
+			return []byte(`{"status":"ok","response":{"type":"order","data":{"statuses":[{"resting":{"oid":1}}]}}}`), nil
+		},
+	}
+	exchange := newMemoryExchange(t, transport)
+	require.NoError(t, exchange.LoadPerpDexs("test"))
+
+	_, err := exchange.Order(CreateOrderRequest{
+		Coin:      "test:XYZ",
+		IsBuy:     true,
+		Price:     100,
+		Size:      3,
+		OrderType: OrderType{Limit: &LimitOrderType{Tif: TifGtc}},
+	}, nil)
+	require.NoError(t, err)
+
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal(transport.exchangePayloads[0], &payload))
+	assert.JSONEq(
+		t,
+		`{"type":"order","orders":[{"a":110001,"b":true,"p":"100","s":"3","r":false,"t":{"limit":{"tif":"Gtc"}}}],"grouping":"na"}`,
+		string(mustMarshal(t, payload["action"])),
+	)
+
+	// Builder perps round like perps (6 decimals), not like spot.
+	px, err := exchange.SlippagePrice("test:ABC", true, 0.01, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 12.4691, px)
+
+	// Coins of dexes that were not loaded are not sent as asset 0
+	_, err = exchange.Order(CreateOrderRequest{
+		Coin:      "flx:TSLA",
+		IsBuy:     true,
+		Price:     100,
+		Size:      3,
+		OrderType: OrderType{Limit: &LimitOrderType{Tif: TifGtc}},
+	}, nil)
+	assert.EqualError(t, err, `order 0: unknown coin "flx:TSLA"`)
+	assert.Len(t, transport.exchangePayloads, 1)
+}
+
+func TestPerpDexAssetOffset(t *testing.T) {
+	assert.Equal(t, 0, perpDexAssetOffset(0))
+	assert.Equal(t, 110000, perpDexAssetOffset(1))
+	assert.Equal(t, 120000, perpDexAssetOffset(2))
+
+	assert.False(t, isSpotAsset(5))
+	assert.True(t, isSpotAsset(10107))
+	assert.False(t, isSpotAsset(110000))
+
+	assert.Equal(t, "", perpDexOf("BTC"))
+	assert.Equal(t, "", perpDexOf("PURR/USDC"))
+	assert.Equal(t, "test", perpDexOf("test:ABC"))
+}
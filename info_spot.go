@@ -0,0 +1,179 @@
+package hyperliquid
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SpotPair is a spot market resolved against the spot token list
+type SpotPair struct {
+	// Coin is the name the API uses for the pair: "PURR/USDC" for canonical
+	// pairs and "@<index>" for the rest.
+	Coin  string
+	Index int
+	Asset int
+	Base  SpotTokenInfo
+	Quote SpotTokenInfo
+}
+
+// Symbol returns the pair as "BASE/QUOTE", e.g. "HYPE/USDC"
+func (p SpotPair) Symbol() string {
+	return p.Base.Name + "/" + p.Quote.Name
+}
+
+// QualifiedName returns the token as "NAME:tokenId", the format spot
+// transfers expect.
+func (t SpotTokenInfo) QualifiedName() string {
+	return t.Name + ":" + t.TokenID
+}
+
+// SpotResolver maps between spot pair names, "@index" names, token symbols and
+// token ids. When names collide, canonical entries win, then the lowest index.
+type SpotResolver struct {
+	pairs       []SpotPair
+	pairByName  map[string]int
+	tokens      []SpotTokenInfo
+	tokenByName map[string]int
+}
+
+// NewSpotResolver indexes meta. Pairs whose tokens are not listed in meta are
+// skipped.
+func NewSpotResolver(meta *SpotMeta) *SpotResolver {
+	r := &SpotResolver{
+		pairByName:  make(map[string]int),
+		tokenByName: make(map[string]int),
+	}
+	if meta == nil {
+		return r
+	}
+
+	tokenByIndex := make(map[int]SpotTokenInfo, len(meta.Tokens))
+	for _, token := range meta.Tokens {
+		tokenByIndex[token.Index] = token
+	}
+
+	// Canonical entries go first so they keep ambiguous names
+	for _, canonical := range []bool{true, false} {
+		for _, token := range meta.Tokens {
+			if token.IsCanonical != canonical {
+				continue
+			}
+			r.tokens = append(r.tokens, token)
+			idx := len(r.tokens) - 1
+			r.addToken(token.Name, idx)
+			r.addToken(token.TokenID, idx)
+			r.addToken(token.QualifiedName(), idx)
+		}
+
+		for _, spotInfo := range meta.Universe {
+			if spotInfo.IsCanonical != canonical || len(spotInfo.Tokens) != 2 {
+				continue
+			}
+			base, ok := tokenByIndex[spotInfo.Tokens[0]]
+			if !ok {
+				continue
+			}
+			quote, ok := tokenByIndex[spotInfo.Tokens[1]]
+			if !ok {
+				continue
+			}
+
+			pair := SpotPair{
+				Coin:  spotInfo.Name,
+				Index: spotInfo.Index,
+				Asset: spotInfo.Index + spotAssetIndexOffset,
+				Base:  base,
+				Quote: quote,
+			}
+			r.pairs = append(r.pairs, pair)
+			idx := len(r.pairs) - 1
+			r.addPair(pair.Coin, idx)
+			r.addPair("@"+strconv.Itoa(pair.Index), idx)
+			r.addPair(pair.Symbol(), idx)
+		}
+	}
+
+	return r
+}
+
+func (r *SpotResolver) addPair(name string, idx int) {
+	if _, exists := r.pairByName[name]; !exists {
+		r.pairByName[name] = idx
+	}
+}
+
+func (r *SpotResolver) addToken(name string, idx int) {
+	if name == "" {
+		return
+	}
+	if _, exists := r.tokenByName[name]; !exists {
+		r.tokenByName[name] = idx
+	}
+}
+
+// Pairs returns every resolved pair, canonical ones first
+func (r *SpotResolver) Pairs() []SpotPair {
+	return r.pairs
+}
+
+// Pair looks a pair up by API name ("PURR/USDC", "@107") or by symbol
+// ("HYPE/USDC").
+func (r *SpotResolver) Pair(name string) (SpotPair, bool) {
+	idx, ok := r.pairByName[name]
+	if !ok {
+		return SpotPair{}, false
+	}
+	return r.pairs[idx], true
+}
+
+// PairByTokens looks a pair up by its base and quote token symbols or ids
+func (r *SpotResolver) PairByTokens(base, quote string) (SpotPair, bool) {
+	baseToken, ok := r.Token(base)
+	if !ok {
+		return SpotPair{}, false
+	}
+	quoteToken, ok := r.Token(quote)
+	if !ok {
+		return SpotPair{}, false
+	}
+	return r.Pair(baseToken.Name + "/" + quoteToken.Name)
+}
+
+// Token looks a token up by symbol ("HYPE"), token id or "NAME:tokenId"
+func (r *SpotResolver) Token(name string) (SpotTokenInfo, bool) {
+	idx, ok := r.tokenByName[name]
+	if !ok {
+		return SpotTokenInfo{}, false
+	}
+	return r.tokens[idx], true
+}
+
+// SpotResolver returns the resolver built from the spot meta the Info was
+// created with.
+func (i *Info) SpotResolver() *SpotResolver {
+	return i.spot
+}
+
+// mapSpotAssets registers every spot pair under its API name, "@index" name
+// and symbol.
+func (i *Info) mapSpotAssets(spotMeta *SpotMeta) {
+	i.spot = NewSpotResolver(spotMeta)
+	for name, idx := range i.spot.pairByName {
+		pair := i.spot.pairs[idx]
+		i.nameToCoin[name] = pair.Coin
+		i.coinToAsset[pair.Coin] = pair.Asset
+		i.assetToDecimal[pair.Asset] = pair.Base.SzDecimals
+	}
+}
+
+// spotToken returns token in the "NAME:tokenId" format. Tokens that are
+// already qualified or unknown are passed through as is.
+func (i *Info) spotToken(token string) string {
+	if strings.Contains(token, ":") || i.spot == nil {
+		return token
+	}
+	if info, ok := i.spot.Token(token); ok {
+		return info.QualifiedName()
+	}
+	return token
+}
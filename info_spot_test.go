@@ -0,0 +1,126 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSpotMeta lists HYPE out of index order so that positional lookups of
+// tokens would pick the wrong one.
+func testSpotMeta() *SpotMeta {
+	return &SpotMeta{
+		Tokens: []SpotTokenInfo{
+			{Name: "USDC", SzDecimals: 8, WeiDecimals: 8, Index: 0, TokenID: "0x6d1e7cde53ba9467b783cb7c530ce054", IsCanonical: true},
+			{Name: "PURR", SzDecimals: 0, WeiDecimals: 5, Index: 1, TokenID: "0xc4bf3f870c0e9465323c0b6ed28096c2", IsCanonical: true},
+			{Name: "HYPE", SzDecimals: 2, WeiDecimals: 8, Index: 150, TokenID: "0x0d01dc56dcaaca66ad901c959b4011ec"},
+			{Name: "JEFF", SzDecimals: 0, WeiDecimals: 5, Index: 2, TokenID: "0xfcf28885456bf7e7cbe5b7a25407c5bc"},
+		},
+		Universe: []SpotAssetInfo{
+			{Name: "PURR/USDC", Tokens: []int{1, 0}, Index: 0, IsCanonical: true},
+			{Name: "@1", Tokens: []int{2, 0}, Index: 1},
+			{Name: "@107", Tokens: []int{150, 0}, Index: 107},
+		},
+	}
+}
+
+func TestSpotResolver(t *testing.T) {
+	resolver := NewSpotResolver(testSpotMeta())
+
+	tests := []struct {
+		name      string
+		wantCoin  string
+		wantAsset int
+	}{
+		{name: "PURR/USDC", wantCoin: "PURR/USDC", wantAsset: 10000},
+		{name: "@0", wantCoin: "PURR/USDC", wantAsset: 10000},
+		{name: "@107", wantCoin: "@107", wantAsset: 10107},
+		{name: "HYPE/USDC", wantCoin: "@107", wantAsset: 10107},
+		{name: "JEFF/USDC", wantCoin: "@1", wantAsset: 10001},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pair, ok := resolver.Pair(tc.name)
+			require.True(t, ok)
+			assert.Equal(t, tc.wantCoin, pair.Coin)
+			assert.Equal(t, tc.wantAsset, pair.Asset)
+		})
+	}
+
+	_, ok := resolver.Pair("HYPE/PURR")
+	assert.False(t, ok)
+
+	pair, ok := resolver.PairByTokens("HYPE", "0x6d1e7cde53ba9467b783cb7c530ce054")
+	require.True(t, ok)
+	assert.Equal(t, "HYPE/USDC", pair.Symbol())
+	assert.Equal(t, 2, pair.Base.SzDecimals)
+
+	for _, name := range []string{"HYPE", "0x0d01dc56dcaaca66ad901c959b4011ec", "HYPE:0x0d01dc56dcaaca66ad901c959b4011ec"} {
+		token, ok := resolver.Token(name)
+		require.True(t, ok, name)
+		assert.Equal(t, "HYPE:0x0d01dc56dcaaca66ad901c959b4011ec", token.QualifiedName())
+	}
+
+	assert.Len(t, resolver.Pairs(), 3)
+	assert.Equal(t, "PURR/USDC", resolver.Pairs()[0].Coin)
+}
+
+func TestInfo_SpotNames(t *testing.T) {
+	info := NewInfo(TestnetAPIURL, true, &Meta{}, testSpotMeta())
+
+	assert.Equal(t, 10107, info.NameToAsset("HYPE/USDC"))
+	assert.Equal(t, 10107, info.NameToAsset("@107"))
+	assert.Equal(t, 10000, info.NameToAsset("@0"))
+	assert.Equal(t, 2, info.assetToDecimal[10107])
+	assert.Equal(t, 0, info.assetToDecimal[10001])
+	assert.Equal(t, "@107", info.coin("HYPE/USDC"))
+}
+
+func TestExchange_SpotNames(t *testing.T) {
+	transport := &memoryTransport{
+		infoResponse: func(req map[string]any) ([]byte, error) {
+			assert.Equal(t, "allMids", req["type"])
+			return []byte(`{"@107":"40.123456","PURR/USDC":"0.2"}`), nil
+		},
+		exchangeResponse: func(map[string]any) ([]byte, error) {
+			return []byte(`{"status":"ok","response":{"type":"default"}}`), nil
+		},
+	}
+
+	privateKey, err := crypto.HexToECDSA(
+		"38d55ff1195c57b9dbc8a72c93119500f1fcd47a33f98149faa18d2fc37932fa",
+	)
+	require.NoError(t, err)
+	exchange := NewExchange(privateKey, TestnetAPIURL, &Meta{}, "", "", testSpotMeta())
+	exchange.SetTransport(transport)
+
+	px, err := exchange.SlippagePrice("HYPE/USDC", true, 0.01, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 40.524691, px)
+
+	for _, token := range []string{"HYPE", "HYPE:0x0d01dc56dcaaca66ad901c959b4011ec"} {
+		_, err = exchange.SpotTransfer(1, "0x0000000000000000000000000000000000000001", token)
+		require.NoError(t, err)
+	}
+	_, err = exchange.SubAccountSpotTransfer("0x0000000000000000000000000000000000000001", true, "PURR", 1)
+	require.NoError(t, err)
+
+	wantTokens := []string{
+		"HYPE:0x0d01dc56dcaaca66ad901c959b4011ec",
+		"HYPE:0x0d01dc56dcaaca66ad901c959b4011ec",
+		"PURR:0xc4bf3f870c0e9465323c0b6ed28096c2",
+	}
+	require.Len(t, transport.exchangePayloads, len(wantTokens))
+	for idx, raw := range transport.exchangePayloads {
+		var payload struct {
+			Action struct {
+				Token string `json:"token"`
+			} `json:"action"`
+		}
+		require.NoError(t, json.Unmarshal(raw, &payload))
+		assert.Equal(t, wantTokens[idx], payload.Action.Token)
+	}
+}
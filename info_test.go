@@ -1,6 +1,11 @@
 package hyperliquid
 
 import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -648,3 +653,388 @@ func TestUserActiveAssetData(t *testing.T) {
 		})
 	}
 }
+
+// newFixtureInfo returns an Info answering from testdata/fixtures/<name>.json.
+// Unlike the cassettes in testdata those are not recordings: each entry is an
+// info request and a hand-written response modeled on the API docs.
+func newFixtureInfo(t *testing.T, name string) *Info {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", "fixtures", name+".json"))
+	require.NoError(t, err)
+	var fixtures []struct {
+		Request  map[string]any  `json:"request"`
+		Response json.RawMessage `json:"response"`
+	}
+	require.NoError(t, json.Unmarshal(data, &fixtures))
+
+	info := NewInfo(TestnetAPIURL, true, &Meta{}, &SpotMeta{})
+	info.SetTransport(&memoryTransport{
+		infoResponse: func(req map[string]any) ([]byte, error) {
+			for _, fixture := range fixtures {
+				if reflect.DeepEqual(fixture.Request, req) {
+					return fixture.Response, nil
+				}
+			}
+			return nil, fmt.Errorf("no fixture for request %v", req)
+		},
+	})
+	return info
+}
+
+func TestHistoricalOrders(t *testing.T) {
+	info := newFixtureInfo(t, "HistoricalOrders")
+	res, err := info.HistoricalOrders("0x31ca8395cf837de08b24da3f660e77761dfb974b")
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	filled := res[0]
+	require.Equal(t, OrderStatusValueFilled, filled.Status)
+	require.Equal(t, int64(37907159219), filled.Order.Oid)
+	require.Equal(t, "0.0025", filled.Order.OrigSz)
+	require.Nil(t, filled.Order.Cloid)
+	require.Empty(t, filled.Order.Children)
+
+	canceled := res[1]
+	require.Equal(t, OrderStatusValueCanceled, canceled.Status)
+	require.Equal(t, int64(1755101320511), canceled.StatusTimestamp)
+	require.Equal(t, stringPtr("0x06c60000000000000000000000003f5a"), canceled.Order.Cloid)
+	require.Len(t, canceled.Order.Children, 1)
+	require.True(t, canceled.Order.Children[0].IsTrigger)
+	require.True(t, canceled.Order.Children[0].ReduceOnly)
+	require.Equal(t, "0.11", canceled.Order.Children[0].TriggerPx)
+}
+
+func TestUserTwapSliceFills(t *testing.T) {
+	info := newFixtureInfo(t, "UserTwapSliceFills")
+	res, err := info.UserTwapSliceFills("0x31ca8395cf837de08b24da3f660e77761dfb974b")
+	require.NoError(t, err)
+	require.Equal(t, []TwapSliceFill{
+		{
+			Fill: Fill{
+				ClosedPnl:     "0.0",
+				Coin:          "ETH",
+				Crossed:       true,
+				Dir:           "Open Long",
+				Hash:          "0x0000000000000000000000000000000000000000000000000000000000000000",
+				Oid:           37907182643,
+				Price:         "4307.4",
+				Side:          "B",
+				StartPosition: "0.0",
+				Size:          "0.0025",
+				Time:          1756721520644,
+				Fee:           "0.004845",
+				FeeToken:      "USDC",
+				Tid:           1070455675927460,
+			},
+			TwapID: 3156,
+		},
+	}, res)
+}
+
+func TestPerpsAtOpenInterestCap(t *testing.T) {
+	info := newFixtureInfo(t, "PerpsAtOpenInterestCap")
+	res, err := info.PerpsAtOpenInterestCap()
+	require.NoError(t, err)
+	require.Equal(t, []string{"BADGER", "CANTO", "FTM", "LOOM", "PURR"}, res)
+}
+
+func TestTwapHistory(t *testing.T) {
+	info := newFixtureInfo(t, "TwapHistory")
+	res, err := info.TwapHistory("0x31ca8395cf837de08b24da3f660e77761dfb974b")
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	require.Equal(t, TwapStatusActivated, res[0].Status.Status)
+	require.Equal(t, 0.0, res[0].State.Progress())
+	require.NotNil(t, res[1].TwapID)
+	require.Equal(t, int64(3156), *res[1].TwapID)
+	require.Equal(t, TwapStatusFinished, res[1].Status.Status)
+	require.Equal(t, 10, res[1].State.Minutes)
+	require.Equal(t, 1.0, res[1].State.Progress())
+	require.InDelta(t, 4307.5, res[1].State.AvgPx(), 1e-9)
+}
+
+func TestUserRateLimit(t *testing.T) {
+	info := newFixtureInfo(t, "UserRateLimit")
+	res, err := info.UserRateLimit("0x31ca8395cf837de08b24da3f660e77761dfb974b")
+	require.NoError(t, err)
+	require.Equal(t, &UserRateLimit{
+		CumVlm:        "2854.593578",
+		NRequestsUsed: 2890,
+		NRequestsCap:  12854,
+	}, res)
+	require.Equal(t, int64(9964), res.Remaining())
+}
+
+func TestUserRole(t *testing.T) {
+	type tc struct {
+		name     string
+		user     string
+		expected *UserRole
+	}
+
+	cases := []tc{
+		{
+			name:     "user",
+			user:     "0x31ca8395cf837de08b24da3f660e77761dfb974b",
+			expected: &UserRole{Role: UserRoleUser},
+		},
+		{
+			name: "agent",
+			user: "0x6188ed13f33d0ba9f9aa45c5db27504c74fcc88b",
+			expected: &UserRole{
+				Role: UserRoleAgent,
+				Data: &UserRoleData{User: "0x31ca8395cf837de08b24da3f660e77761dfb974b"},
+			},
+		},
+		{
+			name:     "missing",
+			user:     "0x0000000000000000000000000000000000000001",
+			expected: &UserRole{Role: UserRoleMissing},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(tt *testing.T) {
+			info := newFixtureInfo(tt, "UserRole")
+			res, err := info.UserRole(tc.user)
+			require.NoError(tt, err)
+			require.Equal(tt, tc.expected, res)
+		})
+	}
+}
+
+func TestMaxBuilderFee(t *testing.T) {
+	info := newFixtureInfo(t, "MaxBuilderFee")
+	res, err := info.MaxBuilderFee(
+		"0x31ca8395cf837de08b24da3f660e77761dfb974b",
+		"0x8e0c473fed9630906779f982cd0f80cb7011812d",
+	)
+	require.NoError(t, err)
+	require.Equal(t, 10, res)
+}
+
+func TestExtraAgents(t *testing.T) {
+	info := newFixtureInfo(t, "ExtraAgents")
+	res, err := info.ExtraAgents("0x31ca8395cf837de08b24da3f660e77761dfb974b")
+	require.NoError(t, err)
+	require.Equal(t, []ExtraAgent{
+		{
+			Address:    "0x6188ed13f33d0ba9f9aa45c5db27504c74fcc88b",
+			Name:       "bot",
+			ValidUntil: 1772273459316,
+		},
+	}, res)
+}
+
+func TestVaultDetails(t *testing.T) {
+	info := newFixtureInfo(t, "VaultDetails")
+	res, err := info.VaultDetails(
+		"0xa15099a30bbf2e68942d6f4c43d70d04faeab0a0",
+		"0x31ca8395cf837de08b24da3f660e77761dfb974b",
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, "Test Vault", res.Name)
+	require.Equal(t, "0x677d831aef5328190852e24f13c46cac05f984e7", res.Leader)
+	require.InDelta(t, 0.3638, res.Apr, 1e-4)
+	require.Equal(t, 0.1, res.LeaderCommission)
+	require.True(t, res.AllowDeposits)
+	require.False(t, res.IsClosed)
+
+	follower := VaultFollower{
+		User:           "0x31ca8395cf837de08b24da3f660e77761dfb974b",
+		VaultEquity:    "111.2",
+		Pnl:            "1.2",
+		AllTimePnl:     "11.2",
+		DaysFollowing:  12,
+		VaultEntryTime: 1755684000000,
+		LockupUntil:    1756029600000,
+	}
+	require.Equal(t, &follower, res.FollowerState)
+	require.Len(t, res.Followers, 2)
+	require.Equal(t, "Leader", res.Followers[0].User)
+	require.Equal(t, follower, res.Followers[1])
+
+	require.Equal(t, &VaultRelationship{
+		Type: VaultRelationshipParent,
+		Data: &VaultRelationshipData{
+			ChildAddresses: []string{"0x010461c14e146ac35fe42271bdc1134ee31c703a"},
+		},
+	}, res.Relationship)
+
+	day, ok := res.Portfolio.Period(PortfolioPeriodDay)
+	require.True(t, ok)
+	require.Equal(t, "25000.0", day.Vlm)
+	require.Equal(t, []Tuple2[int64, string]{
+		{First: 1756636800000, Second: "1000.0"},
+		{First: 1756723200000, Second: "1012.5"},
+	}, day.AccountValueHistory)
+	allTime, ok := res.Portfolio.Period(PortfolioPeriodAllTime)
+	require.True(t, ok)
+	require.Equal(t, "112.5", allTime.PnlHistory[1].Second)
+	_, ok = res.Portfolio.Period(PortfolioPeriodPerpWeek)
+	require.False(t, ok)
+}
+
+func TestUserVaultEquities(t *testing.T) {
+	info := newFixtureInfo(t, "UserVaultEquities")
+	res, err := info.UserVaultEquities("0x31ca8395cf837de08b24da3f660e77761dfb974b")
+	require.NoError(t, err)
+	require.Equal(t, []UserVaultEquity{
+		{
+			VaultAddress:         "0xa15099a30bbf2e68942d6f4c43d70d04faeab0a0",
+			Equity:               "111.2",
+			LockedUntilTimestamp: 1756029600000,
+		},
+	}, res)
+}
+
+func TestLeadingVaults(t *testing.T) {
+	info := newFixtureInfo(t, "LeadingVaults")
+	res, err := info.LeadingVaults("0x677d831aef5328190852e24f13c46cac05f984e7")
+	require.NoError(t, err)
+	require.Equal(t, []LeadingVault{
+		{Address: "0xa15099a30bbf2e68942d6f4c43d70d04faeab0a0", Name: "Test Vault"},
+	}, res)
+
+	res, err = info.LeadingVaults("0x31ca8395cf837de08b24da3f660e77761dfb974b")
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
+
+func TestPortfolio(t *testing.T) {
+	info := newFixtureInfo(t, "Portfolio")
+	res, err := info.Portfolio("0x31ca8395cf837de08b24da3f660e77761dfb974b")
+	require.NoError(t, err)
+	require.Len(t, res, 8)
+
+	week, ok := res.Period(PortfolioPeriodWeek)
+	require.True(t, ok)
+	require.Equal(t, PortfolioHistory{
+		AccountValueHistory: []Tuple2[int64, string]{
+			{First: 1756118400000, Second: "240.00"},
+			{First: 1756723200000, Second: "251.40"},
+		},
+		PnlHistory: []Tuple2[int64, string]{
+			{First: 1756118400000, Second: "0.0"},
+			{First: 1756723200000, Second: "11.40"},
+		},
+		Vlm: "5311.76",
+	}, week)
+
+	perpWeek, ok := res.Period(PortfolioPeriodPerpWeek)
+	require.True(t, ok)
+	require.Empty(t, perpWeek.AccountValueHistory)
+}
+
+func TestUserNonFundingLedgerUpdates(t *testing.T) {
+	info := newFixtureInfo(t, "UserNonFundingLedgerUpdates")
+	endTime := int64(1756800000000)
+	res, err := info.UserNonFundingLedgerUpdates(
+		"0x31ca8395cf837de08b24da3f660e77761dfb974b",
+		1754000000000,
+		&endTime,
+	)
+	require.NoError(t, err)
+	require.Len(t, res, 8)
+
+	types := make([]LedgerDeltaType, len(res))
+	for j, update := range res {
+		types[j] = update.Delta.Type
+	}
+	require.Equal(t, []LedgerDeltaType{
+		LedgerDeltaTypeDeposit,
+		LedgerDeltaTypeAccountClassTransfer,
+		LedgerDeltaTypeSpotTransfer,
+		LedgerDeltaTypeVaultDeposit,
+		LedgerDeltaTypeSend,
+		LedgerDeltaTypeCStakingTransfer,
+		LedgerDeltaTypeWithdraw,
+		"borrowLend",
+	}, types)
+
+	require.Equal(t, &LedgerDeposit{Usdc: "100.0"}, res[0].Delta.Deposit)
+	require.Equal(t, &LedgerAccountClassTransfer{Usdc: "50.0"}, res[1].Delta.AccountClassTransfer)
+	require.Equal(t, "PURR", res[2].Delta.SpotTransfer.Token)
+	require.Equal(t, &LedgerVaultDelta{
+		Vault: "0xa15099a30bbf2e68942d6f4c43d70d04faeab0a0",
+		Usdc:  "110.0",
+	}, res[3].Delta.Vault)
+	require.Equal(t, &LedgerSend{
+		User:           "0x31ca8395cf837de08b24da3f660e77761dfb974b",
+		Destination:    "0x8e0c473fed9630906779f982cd0f80cb7011812d",
+		DestinationDex: "spot",
+		Token:          "USDC",
+		Amount:         "5.0",
+		UsdcValue:      "5.0",
+		Fee:            "0.0",
+		NativeTokenFee: "0.0",
+		Nonce:          1754476800000,
+	}, res[4].Delta.Send)
+	require.Equal(t, &LedgerCStakingTransfer{
+		Token:     "HYPE",
+		Amount:    "2.0",
+		IsDeposit: true,
+	}, res[5].Delta.CStakingTransfer)
+	require.Equal(t, &LedgerWithdraw{Usdc: "20.0", Nonce: 1754649600000, Fee: "1.0"}, res[6].Delta.Withdraw)
+
+	// Unknown delta types keep their payload.
+	require.JSONEq(t,
+		`{"type":"borrowLend","token":"USDC","operation":"supply","amount":"1.0"}`,
+		string(res[7].Delta.Raw))
+}
+
+func TestUserFundingHistory(t *testing.T) {
+	info := newFixtureInfo(t, "UserFundingHistory")
+	endTime := int64(1756728000000)
+	res, err := info.UserFundingHistory(
+		"0x31ca8395cf837de08b24da3f660e77761dfb974b",
+		1756720800000,
+		&endTime,
+	)
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+
+	require.Equal(t, UserFundingHistory{
+		Time: 1756720800054,
+		Hash: "0x0000000000000000000000000000000000000000000000000000000000000000",
+		Delta: UserFundingDelta{
+			Type:        "funding",
+			Coin:        "BTC",
+			Usdc:        "0.004211",
+			Szi:         "-0.00037",
+			FundingRate: "-0.00001003",
+		},
+	}, res[1])
+
+	require.Equal(t, "ETH", res[2].Delta.Coin)
+	require.NotNil(t, res[2].Delta.NSamples)
+	require.Equal(t, 24, *res[2].Delta.NSamples)
+}
+
+func TestUserFills(t *testing.T) {
+	info := newFixtureInfo(t, "UserFills")
+	res, err := info.UserFills("0x31ca8395cf837de08b24da3f660e77761dfb974b")
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+
+	twap := res[0]
+	require.NotNil(t, twap.TwapID)
+	require.Equal(t, int64(3156), *twap.TwapID)
+	require.Nil(t, twap.Cloid)
+	require.Nil(t, twap.Liquidation)
+
+	withCloid := res[1]
+	require.Equal(t, stringPtr("0x06c60000000000000000000000003f5a"), withCloid.Cloid)
+	require.Nil(t, withCloid.TwapID)
+
+	liquidated := res[2]
+	require.Equal(t, &FillLiquidation{
+		LiquidatedUser: "0x31ca8395cf837de08b24da3f660e77761dfb974b",
+		MarkPx:         "180.05",
+		Method:         FillLiquidationMethodMarket,
+	}, liquidated.Liquidation)
+	require.Equal(t, "-3.1205", liquidated.ClosedPnl)
+}
@@ -352,3 +352,120 @@ func TestLevel_UnmarshalJSON_StringToFloat(t *testing.T) {
 	assert.Equal(t, 50000.123, level.Px)
 	assert.Equal(t, 1.456789, level.Sz)
 }
+
+func TestLedgerUpdate_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   string
+		assert func(t *testing.T, delta LedgerDelta)
+	}{
+		{
+			name: "deposit",
+			data: `{"time":1700000000000,"hash":"0xabc","delta":{"type":"deposit","usdc":"100.0"}}`,
+			assert: func(t *testing.T, delta LedgerDelta) {
+				require.NotNil(t, delta.Deposit)
+				assert.Equal(t, LedgerDeltaTypeDeposit, delta.Type)
+				assert.Equal(t, "100.0", delta.Deposit.Usdc)
+			},
+		},
+		{
+			name: "withdraw",
+			data: `{"time":1700000000000,"hash":"0xabc","delta":{"type":"withdraw","usdc":"50.0","nonce":1700000000001,"fee":"1.0"}}`,
+			assert: func(t *testing.T, delta LedgerDelta) {
+				require.NotNil(t, delta.Withdraw)
+				assert.Equal(t, int64(1700000000001), delta.Withdraw.Nonce)
+				assert.Equal(t, "1.0", delta.Withdraw.Fee)
+			},
+		},
+		{
+			name: "liquidation",
+			data: `{"time":1700000000000,"hash":"0xabc","delta":{"type":"liquidation","liquidatedNtlPos":"1000.0","accountValue":"20.0","leverageType":"Cross","liquidatedPositions":[{"coin":"ETH","szi":"-0.5"}]}}`,
+			assert: func(t *testing.T, delta LedgerDelta) {
+				require.NotNil(t, delta.Liquidation)
+				assert.Equal(t, "Cross", delta.Liquidation.LeverageType)
+				assert.Equal(t, []LedgerLiquidatedPosition{{Coin: "ETH", Szi: "-0.5"}}, delta.Liquidation.LiquidatedPositions)
+			},
+		},
+		{
+			name: "vault_deposit",
+			data: `{"time":1700000000000,"hash":"0xabc","delta":{"type":"vaultDeposit","vault":"0xdfc24b077bc1425ad1dea75bcb6f8158e10df303","usdc":"10.0"}}`,
+			assert: func(t *testing.T, delta LedgerDelta) {
+				require.NotNil(t, delta.Vault)
+				assert.Equal(t, LedgerDeltaTypeVaultDeposit, delta.Type)
+				assert.Equal(t, "10.0", delta.Vault.Usdc)
+			},
+		},
+		{
+			name: "account_class_transfer",
+			data: `{"time":1700000000000,"hash":"0xabc","delta":{"type":"accountClassTransfer","usdc":"5.0","toPerp":true}}`,
+			assert: func(t *testing.T, delta LedgerDelta) {
+				require.NotNil(t, delta.AccountClassTransfer)
+				assert.True(t, delta.AccountClassTransfer.ToPerp)
+			},
+		},
+		{
+			name: "unknown_type",
+			data: `{"time":1700000000000,"hash":"0xabc","delta":{"type":"somethingNew","foo":"bar"}}`,
+			assert: func(t *testing.T, delta LedgerDelta) {
+				assert.Equal(t, LedgerDeltaType("somethingNew"), delta.Type)
+				assert.Nil(t, delta.variant())
+				assert.JSONEq(t, `{"type":"somethingNew","foo":"bar"}`, string(delta.Raw))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var update LedgerUpdate
+			require.NoError(t, json.Unmarshal([]byte(tt.data), &update))
+			assert.Equal(t, int64(1700000000000), update.Time)
+			assert.Equal(t, "0xabc", update.Hash)
+			tt.assert(t, update.Delta)
+
+			// Test round-trip
+			jsonData, err := json.Marshal(update)
+			require.NoError(t, err, "marshaling should not fail")
+			assert.JSONEq(t, tt.data, string(jsonData), "round-trip should preserve data")
+		})
+	}
+}
+
+func TestLedgerDelta_MarshalJSON_FromVariant(t *testing.T) {
+	delta := LedgerDelta{
+		Type:    LedgerDeltaTypeDeposit,
+		Deposit: &LedgerDeposit{Usdc: "42.0"},
+	}
+
+	jsonData, err := json.Marshal(delta)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"deposit","usdc":"42.0"}`, string(jsonData))
+}
+
+func TestWebData2_LeadingVaults(t *testing.T) {
+	data := `{"user":"0x31ca8395cf837de08b24da3f660e77761dfb974b","leadingVaults":[{"address":"0xa15099a30bbf2e68942d6f4c43d70d04faeab0a0","name":"Test Vault"}],"totalVaultEquity":"111.2"}`
+
+	var webData WebData2
+	require.NoError(t, json.Unmarshal([]byte(data), &webData))
+	assert.Equal(t, []LeadingVault{
+		{Address: "0xa15099a30bbf2e68942d6f4c43d70d04faeab0a0", Name: "Test Vault"},
+	}, webData.LeadingVaults)
+	assert.Equal(t, "111.2", webData.TotalVaultEquity)
+}
+
+func TestWebData2_TwapStates(t *testing.T) {
+	data := `{"user":"0x31ca8395cf837de08b24da3f660e77761dfb974b","twapStates":[[3156,{"coin":"ETH","executedNtl":"43.075","executedSz":"0.01","minutes":10,"randomize":true,"reduceOnly":false,"side":"B","sz":"0.025","timestamp":1756721400345,"user":"0x31ca8395cf837de08b24da3f660e77761dfb974b"}]]}`
+
+	var webData WebData2
+	require.NoError(t, json.Unmarshal([]byte(data), &webData))
+	require.Len(t, webData.TwapStates, 1)
+
+	state, ok := webData.TwapState(3156)
+	require.True(t, ok)
+	assert.Equal(t, "ETH", state.Coin)
+	assert.True(t, state.Randomize)
+	assert.InDelta(t, 0.4, state.Progress(), 1e-9)
+	assert.InDelta(t, 4307.5, state.AvgPx(), 1e-9)
+
+	_, ok = webData.TwapState(1)
+	assert.False(t, ok)
+}
@@ -0,0 +1,361 @@
+package hyperliquid
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// MultiSigSignature is the signature an authorized user contributed to a
+// multi-sig proposal
+type MultiSigSignature struct {
+	Signer    string          `json:"signer"`
+	Signature SignatureResult `json:"signature"`
+}
+
+// MultiSigProposal is an action of a multi-sig user collecting the
+// signatures of its authorized users. Create it with Exchange.ProposeMultiSig,
+// have each signer add theirs with Sign, possibly on another machine through
+// WriteFile and ReadMultiSigProposal, and submit it with Exchange.MultiSig.
+type MultiSigProposal struct {
+	MultiSigUser string              `json:"multiSigUser"`
+	OuterSigner  string              `json:"outerSigner"`
+	Action       any                 `json:"action"`
+	Nonce        int64               `json:"nonce"`
+	VaultAddress string              `json:"vaultAddress,omitempty"`
+	ExpiresAfter *int64              `json:"expiresAfter,omitempty"`
+	IsMainnet    bool                `json:"isMainnet"`
+	Signatures   []MultiSigSignature `json:"signatures"`
+}
+
+// UnmarshalJSON keeps the action as a RawAction so it hashes like it was
+// signed
+func (p *MultiSigProposal) UnmarshalJSON(data []byte) error {
+	type plain MultiSigProposal
+	var decoded struct {
+		plain
+		Action RawAction `json:"action"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*p = MultiSigProposal(decoded.plain)
+	p.Action = decoded.Action
+	return nil
+}
+
+// WriteFile writes the proposal as indented JSON. It fails if the action
+// would not hash the same once read back.
+func (p *MultiSigProposal) WriteFile(path string) error {
+	raw, err := rawAction(p.Action, p.VaultAddress, p.Nonce, p.ExpiresAfter)
+	if err != nil {
+		return err
+	}
+	file := *p
+	file.Action = raw
+	return writeJSONFile(path, file)
+}
+
+// ReadMultiSigProposal reads a proposal written by MultiSigProposal.WriteFile
+func ReadMultiSigProposal(path string) (*MultiSigProposal, error) {
+	var proposal MultiSigProposal
+	if err := readJSONFile(path, &proposal); err != nil {
+		return nil, err
+	}
+	return &proposal, nil
+}
+
+// ProposeMultiSig creates a proposal to send action for multiSigUser, to be
+// submitted by this exchange once enough authorized users signed it. The
+// nonce, vault and expiresAfter are assigned like BuildAction does. Transfers
+// and withdrawals get their hyperliquidChain and signatureChainId fields set,
+// as authorized users sign them with EIP-712 user signatures.
+func (e *Exchange) ProposeMultiSig(multiSigUser string, action any) (*MultiSigProposal, error) {
+	unsigned, err := e.BuildAction(action)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, typ, userSigned, err := userSignedAction(unsigned.Action)
+	if err != nil {
+		return nil, err
+	}
+	proposed := unsigned.Action
+	if userSigned {
+		fields["hyperliquidChain"] = hyperliquidChain(unsigned.IsMainnet)
+		fields["signatureChainId"] = SignatureChainID
+		proposed, err = userSignedRawAction(fields, typ)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &MultiSigProposal{
+		MultiSigUser: strings.ToLower(multiSigUser),
+		OuterSigner:  strings.ToLower(crypto.PubkeyToAddress(e.privateKey.PublicKey).Hex()),
+		Action:       proposed,
+		Nonce:        unsigned.Nonce,
+		VaultAddress: unsigned.VaultAddress,
+		ExpiresAfter: unsigned.ExpiresAfter,
+		IsMainnet:    unsigned.IsMainnet,
+	}, nil
+}
+
+// Sign adds the signature of privateKey, replacing an earlier one by the same
+// signer
+func (p *MultiSigProposal) Sign(privateKey *ecdsa.PrivateKey) error {
+	fields, typ, userSigned, err := p.userSignedAction()
+	if err != nil {
+		return err
+	}
+
+	var sig SignatureResult
+	if userSigned {
+		sig, err = SignMultiSigUserSignedActionPayload(
+			privateKey,
+			fields,
+			typ.fields,
+			typ.primaryType,
+			p.MultiSigUser,
+			p.OuterSigner,
+			p.IsMainnet,
+		)
+	} else {
+		sig, err = SignMultiSigL1ActionPayload(
+			privateKey,
+			p.Action,
+			p.MultiSigUser,
+			p.OuterSigner,
+			p.VaultAddress,
+			p.Nonce,
+			p.ExpiresAfter,
+			p.IsMainnet,
+		)
+	}
+	if err != nil {
+		return err
+	}
+
+	p.setSignature(MultiSigSignature{
+		Signer:    crypto.PubkeyToAddress(privateKey.PublicKey).Hex(),
+		Signature: sig,
+	})
+	return nil
+}
+
+// AddSignature adds a signature produced elsewhere, replacing an earlier one
+// by the same signer. It fails if the signature is not by Signer.
+func (p *MultiSigProposal) AddSignature(sig MultiSigSignature) error {
+	if err := p.verifySignature(sig); err != nil {
+		return err
+	}
+	p.setSignature(sig)
+	return nil
+}
+
+// Merge adds the signatures of a copy of the proposal, e.g. one returned by
+// a signer
+func (p *MultiSigProposal) Merge(other *MultiSigProposal) error {
+	same := p.MultiSigUser == other.MultiSigUser &&
+		p.OuterSigner == other.OuterSigner &&
+		p.Nonce == other.Nonce &&
+		p.IsMainnet == other.IsMainnet
+	if !same {
+		return errors.New("cannot merge signatures of another proposal")
+	}
+
+	// Signatures over another action do not verify against this one
+	for _, sig := range other.Signatures {
+		if err := p.AddSignature(sig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks that every signature is valid and by an authorized user,
+// that the outer signer is authorized, and that the threshold is met
+func (p *MultiSigProposal) Validate(signers *MultiSigSigners) error {
+	if signers == nil {
+		return fmt.Errorf("%s is not a multi-sig user", p.MultiSigUser)
+	}
+
+	authorized := make(map[string]bool, len(signers.AuthorizedUsers))
+	for _, user := range signers.AuthorizedUsers {
+		authorized[strings.ToLower(user)] = true
+	}
+	if !authorized[strings.ToLower(p.OuterSigner)] {
+		return fmt.Errorf("outer signer %s is not authorized for %s", p.OuterSigner, p.MultiSigUser)
+	}
+
+	signed := make(map[string]bool, len(p.Signatures))
+	for _, sig := range p.Signatures {
+		signer := strings.ToLower(sig.Signer)
+		if signed[signer] {
+			return fmt.Errorf("signer %s signed more than once", sig.Signer)
+		}
+		signed[signer] = true
+		if !authorized[signer] {
+			return fmt.Errorf("signer %s is not authorized for %s", sig.Signer, p.MultiSigUser)
+		}
+		if err := p.verifySignature(sig); err != nil {
+			return err
+		}
+	}
+
+	if len(p.Signatures) < signers.Threshold {
+		return fmt.Errorf(
+			"only %d of the %d required signatures",
+			len(p.Signatures),
+			signers.Threshold,
+		)
+	}
+	return nil
+}
+
+// MultiSig validates a proposal against the current signers of the multi-sig
+// user, signs it as the outer signer and submits it
+func (e *Exchange) MultiSig(proposal *MultiSigProposal) (*MultiSigResponse, error) {
+	outerSigner := crypto.PubkeyToAddress(e.privateKey.PublicKey).Hex()
+	if !strings.EqualFold(outerSigner, proposal.OuterSigner) {
+		return nil, fmt.Errorf(
+			"proposal is to be submitted by %s, not %s",
+			proposal.OuterSigner,
+			outerSigner,
+		)
+	}
+	if isMainnet := e.client.baseURL == MainnetAPIURL; proposal.IsMainnet != isMainnet {
+		return nil, fmt.Errorf(
+			"proposal was signed for mainnet=%t but is submitted to mainnet=%t",
+			proposal.IsMainnet,
+			isMainnet,
+		)
+	}
+
+	signers, err := e.info.QueryUserToMultiSigSigners(proposal.MultiSigUser)
+	if err != nil {
+		return nil, err
+	}
+	if err := proposal.Validate(signers); err != nil {
+		return nil, err
+	}
+
+	signatures := make([]SignatureResult, len(proposal.Signatures))
+	for i, sig := range proposal.Signatures {
+		signatures[i] = sig.Signature
+	}
+	action := MultiSigAction{
+		Type:             "multiSig",
+		SignatureChainID: SignatureChainID,
+		Signatures:       signatures,
+		Payload: MultiSigPayload{
+			MultiSigUser: proposal.MultiSigUser,
+			OuterSigner:  proposal.OuterSigner,
+			Action:       proposal.Action,
+		},
+	}
+
+	sig, err := SignMultiSigAction(
+		e.privateKey,
+		action,
+		proposal.VaultAddress,
+		proposal.Nonce,
+		proposal.ExpiresAfter,
+		proposal.IsMainnet,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := newExchangePayload(
+		action,
+		sig,
+		proposal.Nonce,
+		proposal.VaultAddress,
+		proposal.ExpiresAfter,
+	)
+	resp, err := e.transport.Exchange(context.Background(), payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result MultiSigResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (p *MultiSigProposal) setSignature(sig MultiSigSignature) {
+	for i, existing := range p.Signatures {
+		if strings.EqualFold(existing.Signer, sig.Signer) {
+			p.Signatures[i] = sig
+			return
+		}
+	}
+	p.Signatures = append(p.Signatures, sig)
+}
+
+// verifySignature checks that sig was produced by its signer over the
+// proposal
+func (p *MultiSigProposal) verifySignature(sig MultiSigSignature) error {
+	fields, typ, userSigned, err := p.userSignedAction()
+	if err != nil {
+		return err
+	}
+
+	var signer string
+	if userSigned {
+		envelope, envelopeTypes := multiSigUserSignedEnvelope(
+			fields,
+			typ.fields,
+			p.MultiSigUser,
+			p.OuterSigner,
+		)
+		signer, err = RecoverUserSignedSigner(
+			envelope,
+			envelopeTypes,
+			typ.primaryType,
+			sig.Signature,
+		)
+	} else {
+		signer, err = RecoverL1Signer(
+			multiSigEnvelope(p.Action, p.MultiSigUser, p.OuterSigner),
+			p.VaultAddress,
+			p.Nonce,
+			p.ExpiresAfter,
+			sig.Signature,
+			p.IsMainnet,
+		)
+	}
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(signer, sig.Signer) {
+		return fmt.Errorf("signature of %s was produced by %s", sig.Signer, signer)
+	}
+	return nil
+}
+
+// userSignedAction returns the fields of the proposed action if authorized
+// users sign it with an EIP-712 user signature. Those are signed for the
+// network in their hyperliquidChain field, which must match the proposal.
+func (p *MultiSigProposal) userSignedAction() (map[string]any, userSignedType, bool, error) {
+	fields, typ, userSigned, err := userSignedAction(p.Action)
+	if err != nil || !userSigned {
+		return fields, typ, userSigned, err
+	}
+	if chain := hyperliquidChain(p.IsMainnet); fields["hyperliquidChain"] != chain {
+		return nil, userSignedType{}, false, fmt.Errorf(
+			"action is for %v, not %s",
+			fields["hyperliquidChain"],
+			chain,
+		)
+	}
+	return fields, typ, true, nil
+}
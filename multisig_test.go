@@ -0,0 +1,196 @@
+package hyperliquid
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const multiSigUser = "0x0000000000000000000000000000000000000abc"
+
+func newSigner(t *testing.T) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return key, crypto.PubkeyToAddress(key.PublicKey).Hex()
+}
+
+func multiSigTransport(signers *MultiSigSigners) *memoryTransport {
+	return &memoryTransport{
+		infoResponse: func(map[string]any) ([]byte, error) {
+			return json.Marshal(signers)
+		},
+		exchangeResponse: func(map[string]any) ([]byte, error) {
+			return []byte(`{"status":"ok","response":{"type":"default"}}`), nil
+		},
+	}
+}
+
+func TestMultiSig(t *testing.T) {
+	aliceKey, alice := newSigner(t)
+	bobKey, bob := newSigner(t)
+	_, carol := newSigner(t)
+
+	signers := &MultiSigSigners{Threshold: 2}
+	transport := multiSigTransport(signers)
+	exchange := newMemoryExchange(t, transport)
+	outer := crypto.PubkeyToAddress(exchange.privateKey.PublicKey).Hex()
+	signers.AuthorizedUsers = []string{strings.ToLower(outer), alice, bob, carol}
+
+	action := UsdTransferAction{
+		Type:        "usdSend",
+		Destination: "0x5e9ee1089755c3435139848e47e6635505d5a13a",
+		Amount:      "10",
+		Time:        1700000000000,
+	}
+	proposal, err := exchange.ProposeMultiSig(multiSigUser, action)
+	require.NoError(t, err)
+	assert.Equal(t, action.Time, proposal.Nonce)
+
+	// Each signer signs their own copy of the proposal file
+	dir := t.TempDir()
+	path := filepath.Join(dir, "proposal.json")
+	require.NoError(t, proposal.WriteFile(path))
+	for i, key := range []*ecdsa.PrivateKey{aliceKey, bobKey} {
+		own, err := ReadMultiSigProposal(path)
+		require.NoError(t, err)
+		require.NoError(t, own.Sign(key))
+
+		signedPath := filepath.Join(dir, fmt.Sprintf("signed-%d.json", i))
+		require.NoError(t, own.WriteFile(signedPath))
+		signed, err := ReadMultiSigProposal(signedPath)
+		require.NoError(t, err)
+		require.NoError(t, proposal.Merge(signed))
+	}
+	require.Len(t, proposal.Signatures, 2)
+
+	resp, err := exchange.MultiSig(proposal)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp.Status)
+	assert.JSONEq(t, `{"type":"default"}`, string(resp.Response))
+
+	require.Len(t, transport.infoRequests, 1)
+	assert.JSONEq(
+		t,
+		`{"type":"userToMultiSigSigners","user":"`+multiSigUser+`"}`,
+		string(transport.infoRequests[0]),
+	)
+
+	require.Len(t, transport.exchangePayloads, 1)
+	var payload struct {
+		Action    json.RawMessage `json:"action"`
+		Nonce     int64           `json:"nonce"`
+		Signature SignatureResult `json:"signature"`
+	}
+	require.NoError(t, json.Unmarshal(transport.exchangePayloads[0], &payload))
+	assert.Equal(t, action.Time, payload.Nonce)
+
+	var sent MultiSigAction
+	require.NoError(t, json.Unmarshal(payload.Action, &sent))
+	assert.Equal(t, "multiSig", sent.Type)
+	assert.Equal(t, SignatureChainID, sent.SignatureChainID)
+	assert.Equal(t, multiSigUser, sent.Payload.MultiSigUser)
+	assert.Equal(t, strings.ToLower(outer), sent.Payload.OuterSigner)
+	// The inner action is sent in the field order the API hashes it in
+	inner := `{"type":"usdSend","signatureChainId":"0x66eee","hyperliquidChain":"Testnet",` +
+		`"destination":"0x5e9ee1089755c3435139848e47e6635505d5a13a","amount":"10","time":1700000000000}`
+	var sentRaw struct {
+		Payload struct {
+			Action json.RawMessage `json:"action"`
+		} `json:"payload"`
+	}
+	require.NoError(t, json.Unmarshal(payload.Action, &sentRaw))
+	assert.Equal(t, inner, string(sentRaw.Payload.Action))
+
+	// The outer signature is a user signed envelope over the action hash
+	hash, err := ActionHash(multiSigActionWithoutType{
+		SignatureChainID: sent.SignatureChainID,
+		Signatures:       sent.Signatures,
+		Payload: MultiSigPayload{
+			MultiSigUser: multiSigUser,
+			OuterSigner:  sent.Payload.OuterSigner,
+			Action:       RawAction(inner),
+		},
+	}, "", payload.Nonce, nil)
+	require.NoError(t, err)
+	signer, err := RecoverUserSignedActionSigner(map[string]any{
+		"type":               "multiSig",
+		"signatureChainId":   SignatureChainID,
+		"hyperliquidChain":   "Testnet",
+		"multiSigActionHash": hash,
+		"nonce":              payload.Nonce,
+	}, payload.Signature)
+	require.NoError(t, err)
+	assert.Equal(t, outer, signer)
+
+	// A transfer is signed for the network it names
+	mainnet := *proposal
+	mainnet.IsMainnet = true
+	assert.EqualError(t, mainnet.Sign(aliceKey), "action is for Testnet, not Mainnet")
+}
+
+func TestMultiSigProposal_Validate(t *testing.T) {
+	aliceKey, alice := newSigner(t)
+	bobKey, bob := newSigner(t)
+	malloryKey, _ := newSigner(t)
+
+	exchange := newMemoryExchange(t, &memoryTransport{})
+	outer := crypto.PubkeyToAddress(exchange.privateKey.PublicKey).Hex()
+	signers := &MultiSigSigners{
+		AuthorizedUsers: []string{outer, alice, bob},
+		Threshold:       2,
+	}
+
+	newProposal := func(t *testing.T) *MultiSigProposal {
+		proposal, err := exchange.ProposeMultiSig(multiSigUser, CancelAction{
+			Type:    "cancel",
+			Cancels: []CancelOrderWire{{Asset: 0, OrderID: 1}},
+		})
+		require.NoError(t, err)
+		return proposal
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		proposal := newProposal(t)
+		require.NoError(t, proposal.Sign(aliceKey))
+		require.NoError(t, proposal.Sign(bobKey))
+		assert.NoError(t, proposal.Validate(signers))
+	})
+
+	t.Run("below threshold", func(t *testing.T) {
+		proposal := newProposal(t)
+		require.NoError(t, proposal.Sign(aliceKey))
+		require.NoError(t, proposal.Sign(aliceKey))
+		assert.EqualError(t, proposal.Validate(signers), "only 1 of the 2 required signatures")
+	})
+
+	t.Run("unauthorized signer", func(t *testing.T) {
+		proposal := newProposal(t)
+		require.NoError(t, proposal.Sign(aliceKey))
+		require.NoError(t, proposal.Sign(malloryKey))
+		assert.ErrorContains(t, proposal.Validate(signers), "is not authorized")
+	})
+
+	t.Run("signature of another proposal", func(t *testing.T) {
+		other := newProposal(t)
+		require.NoError(t, other.Sign(bobKey))
+
+		proposal := newProposal(t)
+		require.NoError(t, proposal.Sign(aliceKey))
+		err := proposal.AddSignature(other.Signatures[0])
+		assert.ErrorContains(t, err, "was produced by")
+		assert.ErrorContains(t, proposal.Merge(other), "another proposal")
+	})
+
+	t.Run("not a multi-sig user", func(t *testing.T) {
+		proposal := newProposal(t)
+		assert.ErrorContains(t, proposal.Validate(nil), "is not a multi-sig user")
+	})
+}
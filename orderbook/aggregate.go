@@ -0,0 +1,94 @@
+package orderbook
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Aggregate groups levels into price buckets the way the l2Book subscription
+// does for nSigFigs and mantissa: prices are rounded to nSigFigs significant
+// figures, bids down and asks up, so aggregation never improves a price.
+// Mantissa (1, 2 or 5) widens buckets and is only allowed with nSigFigs 5.
+// nSigFigs 0 returns the book unchanged.
+func (b *Book) Aggregate(nSigFigs, mantissa int) (*Book, error) {
+	if err := validateAggregation(nSigFigs, mantissa); err != nil {
+		return nil, err
+	}
+	if nSigFigs == 0 {
+		return b, nil
+	}
+	if mantissa == 0 {
+		mantissa = 1
+	}
+
+	return &Book{
+		Coin: b.Coin,
+		Time: b.Time,
+		bids: newLadder(aggregateLevels(b.bids.levels, nSigFigs, mantissa, math.Floor), true),
+		asks: newLadder(aggregateLevels(b.asks.levels, nSigFigs, mantissa, math.Ceil), false),
+	}, nil
+}
+
+func validateAggregation(nSigFigs, mantissa int) error {
+	if nSigFigs == 0 {
+		if mantissa != 0 {
+			return fmt.Errorf("orderbook: mantissa requires nSigFigs")
+		}
+		return nil
+	}
+	if nSigFigs < 2 || nSigFigs > 5 {
+		return fmt.Errorf("orderbook: nSigFigs must be between 2 and 5, got %d", nSigFigs)
+	}
+	switch mantissa {
+	case 0, 1:
+	case 2, 5:
+		if nSigFigs != 5 {
+			return fmt.Errorf("orderbook: mantissa %d requires nSigFigs 5", mantissa)
+		}
+	default:
+		return fmt.Errorf("orderbook: mantissa must be 1, 2 or 5, got %d", mantissa)
+	}
+	return nil
+}
+
+// aggregateLevels merges sorted levels into buckets. Sorting is preserved
+// because rounding is monotonic, so equal buckets are always adjacent.
+func aggregateLevels(
+	levels []Level,
+	nSigFigs, mantissa int,
+	round func(float64) float64,
+) []Level {
+	out := make([]Level, 0, len(levels))
+	for _, lvl := range levels {
+		px := bucketPrice(lvl.Px, nSigFigs, mantissa, round)
+		if n := len(out); n > 0 && out[n-1].Px == px {
+			out[n-1].Sz += lvl.Sz
+			out[n-1].N += lvl.N
+			continue
+		}
+		out = append(out, Level{Px: px, Sz: lvl.Sz, N: lvl.N})
+	}
+	return out
+}
+
+func bucketPrice(px float64, nSigFigs, mantissa int, round func(float64) float64) float64 {
+	if px <= 0 {
+		return px
+	}
+	exp := int(math.Floor(math.Log10(px)))
+	step := math.Pow10(exp-nSigFigs+1) * float64(mantissa)
+	// Nudge the quotient so exact multiples are not pushed into the next
+	// bucket by float error.
+	q := px / step
+	if r := math.Round(q); math.Abs(q-r) < 1e-9 {
+		q = r
+	}
+	return cleanFloat(round(q) * step)
+}
+
+// cleanFloat drops float noise such as 0.30000000000000004.
+func cleanFloat(f float64) float64 {
+	v, _ := strconv.ParseFloat(strconv.FormatFloat(f, 'g', 12, 64), 64)
+	return v
+}
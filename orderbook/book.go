@@ -0,0 +1,303 @@
+// Package orderbook maintains local order books from the Hyperliquid l2Book
+// websocket channel and answers depth, VWAP and impact price queries on them.
+package orderbook
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	hl "github.com/sonirico/go-hyperliquid"
+)
+
+var ErrEmptySide = errors.New("orderbook: side is empty")
+
+// Side identifies one side of the book.
+type Side int
+
+const (
+	Bids Side = iota
+	Asks
+)
+
+func (s Side) String() string {
+	if s == Bids {
+		return "bids"
+	}
+	return "asks"
+}
+
+type Level struct {
+	Px float64
+	Sz float64
+	N  int
+}
+
+// Book is an immutable snapshot of a coin's order book. Levels are kept sorted
+// best price first alongside cumulative size and notional, so every query is
+// a binary search.
+type Book struct {
+	Coin string
+	// Time is the exchange timestamp of the snapshot in milliseconds.
+	Time int64
+
+	bids ladder
+	asks ladder
+}
+
+type ladder struct {
+	levels []Level
+	// cumSz[i] and cumNtl[i] sum levels[0..i].
+	cumSz  []float64
+	cumNtl []float64
+	desc   bool
+}
+
+func newLadder(levels []Level, desc bool) ladder {
+	l := ladder{
+		levels: levels,
+		cumSz:  make([]float64, len(levels)),
+		cumNtl: make([]float64, len(levels)),
+		desc:   desc,
+	}
+	var sz, ntl float64
+	for i, lvl := range levels {
+		sz += lvl.Sz
+		ntl += lvl.Sz * lvl.Px
+		l.cumSz[i] = sz
+		l.cumNtl[i] = ntl
+	}
+	return l
+}
+
+// better reports whether a is a better price than b on this side.
+func (l ladder) better(a, b float64) bool {
+	if l.desc {
+		return a > b
+	}
+	return a < b
+}
+
+// search returns the index of the first level not better than px.
+func (l ladder) search(px float64) int {
+	return sort.Search(len(l.levels), func(i int) bool {
+		return !l.better(l.levels[i].Px, px)
+	})
+}
+
+// upTo returns the number of levels at or better than px.
+func (l ladder) upTo(px float64) int {
+	i := l.search(px)
+	if i < len(l.levels) && l.levels[i].Px == px {
+		i++
+	}
+	return i
+}
+
+// New builds a book from levels in any order. Bids and asks are sorted and
+// empty levels dropped.
+func New(coin string, t int64, bids, asks []Level) *Book {
+	bids = sortLevels(bids, true)
+	asks = sortLevels(asks, false)
+	return &Book{
+		Coin: coin,
+		Time: t,
+		bids: newLadder(bids, true),
+		asks: newLadder(asks, false),
+	}
+}
+
+func sortLevels(levels []Level, desc bool) []Level {
+	out := make([]Level, 0, len(levels))
+	for _, lvl := range levels {
+		if lvl.Sz > 0 {
+			out = append(out, lvl)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if desc {
+			return out[i].Px > out[j].Px
+		}
+		return out[i].Px < out[j].Px
+	})
+	return out
+}
+
+// FromL2Book converts an l2Book message into a Book.
+func FromL2Book(msg hl.L2Book) (*Book, error) {
+	if len(msg.Levels) != 2 {
+		return nil, fmt.Errorf("orderbook: expected 2 sides, got %d", len(msg.Levels))
+	}
+	return New(msg.Coin, msg.Time, toLevels(msg.Levels[0]), toLevels(msg.Levels[1])), nil
+}
+
+func toLevels(levels []hl.Level) []Level {
+	out := make([]Level, len(levels))
+	for i, lvl := range levels {
+		out[i] = Level{Px: lvl.Px, Sz: lvl.Sz, N: lvl.N}
+	}
+	return out
+}
+
+func (b *Book) ladder(side Side) ladder {
+	if side == Bids {
+		return b.bids
+	}
+	return b.asks
+}
+
+// Levels returns a copy of one side, best price first.
+func (b *Book) Levels(side Side) []Level {
+	return append([]Level(nil), b.ladder(side).levels...)
+}
+
+func (b *Book) BestBid() (Level, bool) {
+	return b.best(Bids)
+}
+
+func (b *Book) BestAsk() (Level, bool) {
+	return b.best(Asks)
+}
+
+func (b *Book) best(side Side) (Level, bool) {
+	l := b.ladder(side)
+	if len(l.levels) == 0 {
+		return Level{}, false
+	}
+	return l.levels[0], true
+}
+
+// Mid returns the midpoint between the best bid and best ask.
+func (b *Book) Mid() (float64, bool) {
+	bid, okBid := b.BestBid()
+	ask, okAsk := b.BestAsk()
+	if !okBid || !okAsk {
+		return 0, false
+	}
+	return (bid.Px + ask.Px) / 2, true
+}
+
+// Spread returns best ask minus best bid.
+func (b *Book) Spread() (float64, bool) {
+	bid, okBid := b.BestBid()
+	ask, okAsk := b.BestAsk()
+	if !okBid || !okAsk {
+		return 0, false
+	}
+	return ask.Px - bid.Px, true
+}
+
+// DepthAt returns the level resting at exactly px.
+func (b *Book) DepthAt(side Side, px float64) (Level, bool) {
+	l := b.ladder(side)
+	i := l.search(px)
+	if i < len(l.levels) && l.levels[i].Px == px {
+		return l.levels[i], true
+	}
+	return Level{}, false
+}
+
+// CumulativeSize returns the total size resting at px or better.
+func (b *Book) CumulativeSize(side Side, px float64) float64 {
+	l := b.ladder(side)
+	n := l.upTo(px)
+	if n == 0 {
+		return 0
+	}
+	return l.cumSz[n-1]
+}
+
+// CumulativeNotional returns the total notional resting at px or better.
+func (b *Book) CumulativeNotional(side Side, px float64) float64 {
+	l := b.ladder(side)
+	n := l.upTo(px)
+	if n == 0 {
+		return 0
+	}
+	return l.cumNtl[n-1]
+}
+
+// takerLadder returns the side a taker order consumes.
+func (b *Book) takerLadder(isBuy bool) ladder {
+	if isBuy {
+		return b.asks
+	}
+	return b.bids
+}
+
+// fill walks the ladder until the cumulative size (bySize) or notional
+// reaches target and returns the consumed size, notional and the price of
+// the last level touched.
+func fill(l ladder, bySize bool, target float64) (sz, ntl, px float64, err error) {
+	if len(l.levels) == 0 {
+		return 0, 0, 0, ErrEmptySide
+	}
+	cum := l.cumNtl
+	if bySize {
+		cum = l.cumSz
+	}
+	if target <= 0 {
+		return 0, 0, l.levels[0].Px, nil
+	}
+
+	i := sort.SearchFloat64s(cum, target)
+	if i == len(cum) {
+		last := len(cum) - 1
+		return l.cumSz[last], l.cumNtl[last], l.levels[last].Px, fmt.Errorf(
+			"orderbook: insufficient depth: %g available, %g requested", cum[last], target)
+	}
+
+	var prevSz, prevNtl float64
+	if i > 0 {
+		prevSz, prevNtl = l.cumSz[i-1], l.cumNtl[i-1]
+	}
+	lvl := l.levels[i]
+	if bySize {
+		rest := target - prevSz
+		return target, prevNtl + rest*lvl.Px, lvl.Px, nil
+	}
+	rest := (target - prevNtl) / lvl.Px
+	return prevSz + rest, target, lvl.Px, nil
+}
+
+// VWAP returns the average price a taker order of sz would fill at.
+func (b *Book) VWAP(isBuy bool, sz float64) (float64, error) {
+	l := b.takerLadder(isBuy)
+	filled, ntl, _, err := fill(l, true, sz)
+	if err != nil {
+		return 0, err
+	}
+	if filled == 0 {
+		return l.levels[0].Px, nil
+	}
+	return ntl / filled, nil
+}
+
+// SizeForNotional returns the size a taker order must trade to spend notional.
+func (b *Book) SizeForNotional(isBuy bool, notional float64) (float64, error) {
+	l := b.takerLadder(isBuy)
+	sz, _, _, err := fill(l, false, notional)
+	return sz, err
+}
+
+// ImpactPrice returns the worst price a taker order of the given notional
+// reaches.
+func (b *Book) ImpactPrice(isBuy bool, notional float64) (float64, error) {
+	l := b.takerLadder(isBuy)
+	_, _, px, err := fill(l, false, notional)
+	if err != nil {
+		return 0, err
+	}
+	return px, nil
+}
+
+// Age returns how old the snapshot is relative to now.
+func (b *Book) Age(now time.Time) time.Duration {
+	return now.Sub(time.UnixMilli(b.Time))
+}
+
+// IsStale reports whether the snapshot is older than maxAge.
+func (b *Book) IsStale(now time.Time, maxAge time.Duration) bool {
+	return b.Age(now) > maxAge
+}
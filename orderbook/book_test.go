@@ -0,0 +1,211 @@
+package orderbook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	hl "github.com/sonirico/go-hyperliquid"
+)
+
+func testBook() *Book {
+	return New("BTC", 1_700_000_000_000,
+		[]Level{
+			{Px: 99, Sz: 2, N: 1},
+			{Px: 100, Sz: 1, N: 2},
+			{Px: 98, Sz: 3, N: 1},
+			{Px: 97, Sz: 0, N: 0},
+		},
+		[]Level{
+			{Px: 103, Sz: 3, N: 1},
+			{Px: 101, Sz: 1, N: 1},
+			{Px: 102, Sz: 2, N: 4},
+		},
+	)
+}
+
+func TestBook_Top(t *testing.T) {
+	b := testBook()
+
+	bid, ok := b.BestBid()
+	require.True(t, ok)
+	assert.Equal(t, Level{Px: 100, Sz: 1, N: 2}, bid)
+
+	ask, ok := b.BestAsk()
+	require.True(t, ok)
+	assert.Equal(t, Level{Px: 101, Sz: 1, N: 1}, ask)
+
+	mid, ok := b.Mid()
+	require.True(t, ok)
+	assert.Equal(t, 100.5, mid)
+
+	spread, ok := b.Spread()
+	require.True(t, ok)
+	assert.Equal(t, 1.0, spread)
+
+	assert.Len(t, b.Levels(Bids), 3, "empty levels are dropped")
+
+	empty := New("ETH", 0, nil, nil)
+	_, ok = empty.BestBid()
+	assert.False(t, ok)
+	_, ok = empty.Mid()
+	assert.False(t, ok)
+}
+
+func TestBook_Depth(t *testing.T) {
+	b := testBook()
+
+	lvl, ok := b.DepthAt(Bids, 99)
+	require.True(t, ok)
+	assert.Equal(t, 2.0, lvl.Sz)
+	_, ok = b.DepthAt(Bids, 99.5)
+	assert.False(t, ok)
+	_, ok = b.DepthAt(Asks, 99)
+	assert.False(t, ok)
+
+	tests := []struct {
+		side    Side
+		px      float64
+		wantSz  float64
+		wantNtl float64
+	}{
+		{side: Bids, px: 100, wantSz: 1, wantNtl: 100},
+		{side: Bids, px: 98.5, wantSz: 3, wantNtl: 298},
+		{side: Bids, px: 50, wantSz: 6, wantNtl: 592},
+		{side: Bids, px: 101, wantSz: 0, wantNtl: 0},
+		{side: Asks, px: 102, wantSz: 3, wantNtl: 305},
+		{side: Asks, px: 100, wantSz: 0, wantNtl: 0},
+	}
+	for _, tc := range tests {
+		assert.Equal(t, tc.wantSz, b.CumulativeSize(tc.side, tc.px), "%s %g", tc.side, tc.px)
+		assert.Equal(t, tc.wantNtl, b.CumulativeNotional(tc.side, tc.px), "%s %g", tc.side, tc.px)
+	}
+}
+
+func TestBook_Taker(t *testing.T) {
+	b := testBook()
+
+	vwap, err := b.VWAP(true, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 101.5, vwap)
+
+	vwap, err = b.VWAP(false, 3)
+	require.NoError(t, err)
+	assert.InDelta(t, (100+2*99)/3.0, vwap, 1e-12)
+
+	vwap, err = b.VWAP(true, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 101.0, vwap)
+
+	_, err = b.VWAP(true, 10)
+	assert.ErrorContains(t, err, "insufficient depth")
+
+	sz, err := b.SizeForNotional(true, 305)
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, sz)
+
+	sz, err = b.SizeForNotional(true, 203)
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, sz)
+
+	px, err := b.ImpactPrice(true, 250)
+	require.NoError(t, err)
+	assert.Equal(t, 102.0, px)
+
+	px, err = b.ImpactPrice(false, 50)
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, px)
+
+	_, err = New("ETH", 0, nil, nil).ImpactPrice(true, 1)
+	assert.ErrorIs(t, err, ErrEmptySide)
+}
+
+func TestBook_Aggregate(t *testing.T) {
+	b := New("BTC", 0,
+		[]Level{
+			{Px: 113377, Sz: 1, N: 1},
+			{Px: 113360, Sz: 2, N: 1},
+			{Px: 113299, Sz: 4, N: 2},
+		},
+		[]Level{
+			{Px: 113378, Sz: 1, N: 1},
+			{Px: 113391, Sz: 2, N: 1},
+			{Px: 113401, Sz: 4, N: 1},
+		},
+	)
+
+	tests := []struct {
+		name     string
+		nSigFigs int
+		mantissa int
+		bids     []Level
+		asks     []Level
+		wantErr  string
+	}{
+		{
+			name:     "four significant figures",
+			nSigFigs: 4,
+			bids:     []Level{{Px: 113300, Sz: 3, N: 2}, {Px: 113200, Sz: 4, N: 2}},
+			asks:     []Level{{Px: 113400, Sz: 3, N: 2}, {Px: 113500, Sz: 4, N: 1}},
+		},
+		{
+			name:     "five significant figures",
+			nSigFigs: 5,
+			bids:     []Level{{Px: 113370, Sz: 1, N: 1}, {Px: 113360, Sz: 2, N: 1}, {Px: 113290, Sz: 4, N: 2}},
+			asks:     []Level{{Px: 113380, Sz: 1, N: 1}, {Px: 113400, Sz: 2, N: 1}, {Px: 113410, Sz: 4, N: 1}},
+		},
+		{
+			name:     "mantissa 5",
+			nSigFigs: 5,
+			mantissa: 5,
+			bids:     []Level{{Px: 113350, Sz: 3, N: 2}, {Px: 113250, Sz: 4, N: 2}},
+			asks:     []Level{{Px: 113400, Sz: 3, N: 2}, {Px: 113450, Sz: 4, N: 1}},
+		},
+		{name: "too few figures", nSigFigs: 1, wantErr: "between 2 and 5"},
+		{name: "mantissa without 5 figures", nSigFigs: 4, mantissa: 2, wantErr: "requires nSigFigs 5"},
+		{name: "bad mantissa", nSigFigs: 5, mantissa: 3, wantErr: "must be 1, 2 or 5"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			agg, err := b.Aggregate(tc.nSigFigs, tc.mantissa)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.bids, agg.Levels(Bids))
+			assert.Equal(t, tc.asks, agg.Levels(Asks))
+		})
+	}
+
+	small := New("DOGE", 0, []Level{{Px: 0.21347, Sz: 10}, {Px: 0.21341, Sz: 5}}, nil)
+	agg, err := small.Aggregate(3, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []Level{{Px: 0.213, Sz: 15}}, agg.Levels(Bids))
+}
+
+func TestBook_FromL2Book(t *testing.T) {
+	b, err := FromL2Book(hl.L2Book{
+		Coin: "ETH",
+		Time: 1_700_000_000_000,
+		Levels: [][]hl.Level{
+			{{Px: 3000, Sz: 1, N: 1}},
+			{{Px: 3001, Sz: 2, N: 1}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ETH", b.Coin)
+	ask, _ := b.BestAsk()
+	assert.Equal(t, 3001.0, ask.Px)
+
+	now := time.UnixMilli(1_700_000_000_000 + 1500)
+	assert.Equal(t, 1500*time.Millisecond, b.Age(now))
+	assert.True(t, b.IsStale(now, time.Second))
+	assert.False(t, b.IsStale(now, 2*time.Second))
+
+	_, err = FromL2Book(hl.L2Book{Coin: "ETH", Levels: [][]hl.Level{{}}})
+	assert.Error(t, err)
+}
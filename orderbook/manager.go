@@ -0,0 +1,96 @@
+package orderbook
+
+import (
+	"sync"
+	"time"
+
+	hl "github.com/sonirico/go-hyperliquid"
+)
+
+// Manager keeps the latest book per coin. It is safe for concurrent use.
+type Manager struct {
+	mu    sync.RWMutex
+	books map[string]*Book
+}
+
+func NewManager() *Manager {
+	return &Manager{books: make(map[string]*Book)}
+}
+
+// Apply replaces the coin's book with msg. Snapshots older than the current
+// one are ignored and reported as not applied.
+func (m *Manager) Apply(msg hl.L2Book) (*Book, bool, error) {
+	book, err := FromL2Book(msg)
+	if err != nil {
+		return nil, false, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if cur, ok := m.books[book.Coin]; ok && cur.Time > book.Time {
+		return cur, false, nil
+	}
+	m.books[book.Coin] = book
+	return book, true, nil
+}
+
+// Book returns the latest snapshot for coin.
+func (m *Manager) Book(coin string) (*Book, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	book, ok := m.books[coin]
+	return book, ok
+}
+
+// Coins returns the coins that have a book.
+func (m *Manager) Coins() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	coins := make([]string, 0, len(m.books))
+	for coin := range m.books {
+		coins = append(coins, coin)
+	}
+	return coins
+}
+
+// Stale returns the coins whose book is older than maxAge.
+func (m *Manager) Stale(now time.Time, maxAge time.Duration) []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var coins []string
+	for coin, book := range m.books {
+		if book.IsStale(now, maxAge) {
+			coins = append(coins, coin)
+		}
+	}
+	return coins
+}
+
+// Remove drops the coin's book.
+func (m *Manager) Remove(coin string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.books, coin)
+}
+
+// Subscribe feeds the manager from ws.L2Book. onUpdate, if set, is called
+// with each applied book or with errors from the stream.
+func (m *Manager) Subscribe(
+	ws *hl.WebsocketClient,
+	params hl.L2BookSubscriptionParams,
+	onUpdate func(*Book, error),
+) (*hl.Subscription, error) {
+	return ws.L2Book(params, func(msg hl.L2Book, err error) {
+		if err != nil {
+			if onUpdate != nil {
+				onUpdate(nil, err)
+			}
+			return
+		}
+		book, applied, err := m.Apply(msg)
+		if onUpdate != nil && (applied || err != nil) {
+			onUpdate(book, err)
+		}
+	})
+}
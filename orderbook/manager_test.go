@@ -0,0 +1,50 @@
+package orderbook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	hl "github.com/sonirico/go-hyperliquid"
+)
+
+func l2(coin string, t int64, bidPx float64) hl.L2Book {
+	return hl.L2Book{
+		Coin: coin,
+		Time: t,
+		Levels: [][]hl.Level{
+			{{Px: bidPx, Sz: 1, N: 1}},
+			{{Px: bidPx + 1, Sz: 1, N: 1}},
+		},
+	}
+}
+
+func TestManager_Apply(t *testing.T) {
+	m := NewManager()
+
+	_, applied, err := m.Apply(l2("BTC", 2000, 100))
+	require.NoError(t, err)
+	assert.True(t, applied)
+
+	book, applied, err := m.Apply(l2("BTC", 1000, 90))
+	require.NoError(t, err)
+	assert.False(t, applied, "older snapshot must be ignored")
+	bid, _ := book.BestBid()
+	assert.Equal(t, 100.0, bid.Px)
+
+	_, applied, err = m.Apply(l2("ETH", 1500, 10))
+	require.NoError(t, err)
+	assert.True(t, applied)
+
+	assert.ElementsMatch(t, []string{"BTC", "ETH"}, m.Coins())
+	assert.Equal(t, []string{"ETH"}, m.Stale(time.UnixMilli(2500), 800*time.Millisecond))
+
+	m.Remove("ETH")
+	_, ok := m.Book("ETH")
+	assert.False(t, ok)
+	book, ok = m.Book("BTC")
+	require.True(t, ok)
+	assert.Equal(t, int64(2000), book.Time)
+}
@@ -0,0 +1,132 @@
+package hyperliquid
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+var errEmptyOrderRef = errors.New("empty order ref: use OidRef or CloidRef")
+
+// OrderRef identifies an order either by its exchange order id or by its
+// client order id. It encodes as a number or as the cloid string, which is
+// what modify actions and order status queries expect. Build it with OidRef or
+// CloidRef; the zero value refers to no order.
+type OrderRef struct {
+	oid      int64
+	cloid    Cloid
+	hasOid   bool
+	hasCloid bool
+}
+
+// OidRef refers to an order by exchange order id
+func OidRef(oid int64) OrderRef {
+	return OrderRef{oid: oid, hasOid: true}
+}
+
+// CloidRef refers to an order by client order id
+func CloidRef(cloid Cloid) OrderRef {
+	return OrderRef{cloid: cloid, hasCloid: true}
+}
+
+// Oid returns the exchange order id, if r refers to one
+func (r OrderRef) Oid() (int64, bool) {
+	return r.oid, r.hasOid
+}
+
+// Cloid returns the client order id, if r refers to one
+func (r OrderRef) Cloid() (Cloid, bool) {
+	return r.cloid, r.hasCloid
+}
+
+// IsZero reports whether r refers to no order
+func (r OrderRef) IsZero() bool {
+	return !r.hasOid && !r.hasCloid
+}
+
+func (r OrderRef) String() string {
+	switch {
+	case r.hasOid:
+		return strconv.FormatInt(r.oid, 10)
+	case r.hasCloid:
+		return r.cloid.String()
+	default:
+		return ""
+	}
+}
+
+// wire returns the value sent to the API
+func (r OrderRef) wire() (any, error) {
+	switch {
+	case r.hasOid:
+		return r.oid, nil
+	case r.hasCloid:
+		return r.cloid.String(), nil
+	default:
+		return nil, errEmptyOrderRef
+	}
+}
+
+func (r OrderRef) MarshalJSON() ([]byte, error) {
+	v, err := r.wire()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+func (r *OrderRef) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(data, []byte(`"`)) {
+		var cloid Cloid
+		if err := json.Unmarshal(data, &cloid); err != nil {
+			return err
+		}
+		*r = CloidRef(cloid)
+		return nil
+	}
+
+	var oid int64
+	if err := json.Unmarshal(data, &oid); err != nil {
+		return err
+	}
+	*r = OidRef(oid)
+	return nil
+}
+
+func (r OrderRef) EncodeMsgpack(enc *msgpack.Encoder) error {
+	switch {
+	case r.hasOid:
+		return enc.EncodeInt(r.oid)
+	case r.hasCloid:
+		return enc.EncodeString(r.cloid.String())
+	default:
+		return errEmptyOrderRef
+	}
+}
+
+func (r *OrderRef) DecodeMsgpack(dec *msgpack.Decoder) error {
+	code, err := dec.PeekCode()
+	if err != nil {
+		return err
+	}
+
+	if msgpcode.IsString(code) {
+		var cloid Cloid
+		if err := cloid.DecodeMsgpack(dec); err != nil {
+			return err
+		}
+		*r = CloidRef(cloid)
+		return nil
+	}
+
+	oid, err := dec.DecodeInt64()
+	if err != nil {
+		return err
+	}
+	*r = OidRef(oid)
+	return nil
+}
@@ -0,0 +1,176 @@
+package hyperliquid
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func TestOrderRef_Encoding(t *testing.T) {
+	cloid := MustParseCloid("0x00000000000000000000000000000001")
+
+	tests := []struct {
+		name        string
+		ref         OrderRef
+		wantJSON    string
+		wantMsgpack string
+	}{
+		{
+			name:        "oid",
+			ref:         OidRef(77738308),
+			wantJSON:    `77738308`,
+			wantMsgpack: "ce04a23144",
+		},
+		{
+			name:        "small oid",
+			ref:         OidRef(5),
+			wantJSON:    `5`,
+			wantMsgpack: "05",
+		},
+		{
+			name:        "cloid",
+			ref:         CloidRef(cloid),
+			wantJSON:    `"0x00000000000000000000000000000001"`,
+			wantMsgpack: "d922" + hex.EncodeToString([]byte(cloid.String())),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.ref)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantJSON, string(data))
+
+			var fromJSON OrderRef
+			require.NoError(t, json.Unmarshal(data, &fromJSON))
+			assert.Equal(t, tc.ref, fromJSON)
+
+			packed, err := packAction(tc.ref)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantMsgpack, hex.EncodeToString(packed))
+
+			var fromMsgpack OrderRef
+			require.NoError(t, msgpack.Unmarshal(packed, &fromMsgpack))
+			assert.Equal(t, tc.ref, fromMsgpack)
+		})
+	}
+}
+
+func TestOrderRef_Zero(t *testing.T) {
+	var ref OrderRef
+	assert.True(t, ref.IsZero())
+	assert.Equal(t, "", ref.String())
+
+	_, err := json.Marshal(ref)
+	assert.Error(t, err)
+	_, err = packAction(ref)
+	assert.Error(t, err)
+
+	exchange := newMemoryExchange(t, &memoryTransport{})
+	_, err = exchange.ModifyOrder(ModifyOrderRequest{
+		Order: CreateOrderRequest{Coin: "ETH", Price: 1, Size: 1, OrderType: OrderType{Limit: &LimitOrderType{Tif: TifGtc}}},
+	})
+	assert.ErrorIs(t, err, errEmptyOrderRef)
+	_, err = exchange.CancelByRef("ETH", ref)
+	assert.ErrorIs(t, err, errEmptyOrderRef)
+}
+
+func TestExchange_BulkModifyOrdersWire(t *testing.T) {
+	cloid := MustParseCloid("0x00000000000000000000000000000001")
+	order := CreateOrderRequest{
+		Coin:      "ETH",
+		IsBuy:     true,
+		Price:     100,
+		Size:      100,
+		OrderType: OrderType{Limit: &LimitOrderType{Tif: TifGtc}},
+	}
+
+	// msgpack.packb of the action built by the reference SDK's bulk_modify_orders_new
+	tests := []struct {
+		name        string
+		ref         OrderRef
+		wantMsgpack string
+	}{
+		{
+			name: "oid",
+			ref:  OidRef(77738308),
+			wantMsgpack: "82a474797065ab62617463684d6f64696679a86d6f6469666965739182a36f6964ce04a23144" +
+				"a56f7264657286a16101a162c3a170a3313030a173a3313030a172c2a17481a56c696d697481a374" +
+				"6966a3477463",
+		},
+		{
+			name: "cloid",
+			ref:  CloidRef(cloid),
+			wantMsgpack: "82a474797065ab62617463684d6f64696679a86d6f6469666965739182a36f6964d9223078303030" +
+				"3030303030303030303030303030303030303030303030303030303031a56f7264657286a16101a1" +
+				"62c3a170a3313030a173a3313030a172c2a17481a56c696d697481a3746966a3477463",
+		},
+	}
+
+	exchange := newMemoryExchange(t, &memoryTransport{})
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			action, err := newModifyOrdersAction(exchange, []ModifyOrderRequest{{Oid: tc.ref, Order: order}})
+			require.NoError(t, err)
+
+			packed, err := packAction(action)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantMsgpack, hex.EncodeToString(packed))
+		})
+	}
+}
+
+func TestExchange_CancelByRef(t *testing.T) {
+	transport := &memoryTransport{
+		exchangeResponse: func(map[string]any) ([]byte, error) {
+			return []byte(`{"status":"ok","response":{"type":"cancel","data":{"statuses":["success"]}}}`), nil
+		},
+	}
+	exchange := newMemoryExchange(t, transport)
+
+	_, err := exchange.CancelByRef("ETH", OidRef(42))
+	require.NoError(t, err)
+	_, err = exchange.CancelByRef("BTC", CloidRef(MustParseCloid("0x00000000000000000000000000000001")))
+	require.NoError(t, err)
+
+	require.Len(t, transport.exchangePayloads, 2)
+	wantActions := []string{
+		`{"type":"cancel","cancels":[{"a":1,"o":42}]}`,
+		`{"type":"cancelByCloid","cancels":[{"asset":0,"cloid":"0x00000000000000000000000000000001"}]}`,
+	}
+	for idx, raw := range transport.exchangePayloads {
+		var payload map[string]any
+		require.NoError(t, json.Unmarshal(raw, &payload))
+		assert.JSONEq(t, wantActions[idx], string(mustMarshal(t, payload["action"])))
+	}
+}
+
+func TestInfo_QueryOrder(t *testing.T) {
+	transport := &memoryTransport{
+		infoResponse: func(map[string]any) ([]byte, error) {
+			return []byte(`{"status":"unknownOid"}`), nil
+		},
+	}
+	info := NewInfo(TestnetAPIURL, true, &Meta{}, &SpotMeta{})
+	info.SetTransport(transport)
+
+	user := "0x31ca8395cf837de08b24da3f660e77761dfb974b"
+	_, err := info.QueryOrder(user, OidRef(42))
+	require.NoError(t, err)
+	_, err = info.QueryOrderByCloid(user, MustParseCloid("0x00000000000000000000000000000001"))
+	require.NoError(t, err)
+	_, err = info.QueryOrder(user, OrderRef{})
+	assert.ErrorIs(t, err, errEmptyOrderRef)
+
+	require.Len(t, transport.infoRequests, 2)
+	assert.JSONEq(t, `{"type":"orderStatus","user":"`+user+`","oid":42}`, string(transport.infoRequests[0]))
+	assert.JSONEq(
+		t,
+		`{"type":"orderStatus","user":"`+user+`","oid":"0x00000000000000000000000000000001"}`,
+		string(transport.infoRequests[1]),
+	)
+}
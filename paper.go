@@ -0,0 +1,268 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// PaperConfig configures a PaperTransport
+type PaperConfig struct {
+	// User is the account that is simulated. Info queries about it are answered
+	// from the paper state and its fee rates are used for fills.
+	User string
+	// Balance is the starting USDC balance
+	Balance float64
+	// Fees sets the fee rates. When nil they are fetched with userFees for
+	// User on the first action.
+	Fees *UserFees
+	// DefaultLeverage is the cross leverage of coins that had no
+	// updateLeverage action. Zero means 20.
+	DefaultLeverage int
+}
+
+// PaperTransport is a Transport for dry runs and paper trading. Actions are
+// signed as usual, but instead of being posted they are filled by a simulated
+// matching engine against the live L2 book and mids of the wrapped transport.
+// Responses have the same shape as the real ones.
+//
+// Order, cancel, cancelByCloid, modify, batchModify and updateLeverage actions
+// are supported, for perps only. Info queries about the paper user
+// (clearinghouseState, openOrders, frontendOpenOrders and userFills) are
+// answered from the simulated state, the rest go to the wrapped transport.
+//
+// Resting orders and triggers are matched against the mids whenever the
+// transport is used.
+type PaperTransport struct {
+	market Transport
+	info   *Info
+	config PaperConfig
+
+	mu        sync.Mutex
+	balance   float64
+	fees      *UserFees
+	mids      map[string]float64
+	positions map[string]*paperPosition
+	orders    []*paperOrder
+	filled    map[int64]float64
+	fills     []Fill
+	nextOid   int64
+	nextTid   int64
+}
+
+// NewPaperTransport simulates actions for config.User. market provides the
+// market data and info resolves assets.
+func NewPaperTransport(market Transport, info *Info, config PaperConfig) *PaperTransport {
+	if config.DefaultLeverage == 0 {
+		config.DefaultLeverage = 20
+	}
+
+	return &PaperTransport{
+		market:    market,
+		info:      info,
+		config:    config,
+		balance:   config.Balance,
+		fees:      config.Fees,
+		mids:      make(map[string]float64),
+		positions: make(map[string]*paperPosition),
+		filled:    make(map[int64]float64),
+		nextOid:   1,
+		nextTid:   1,
+	}
+}
+
+// EnablePaperTrading switches the exchange to paper trading and returns the
+// transport that simulates it. Actions keep being built and signed, but are
+// never sent. config.User defaults to the account address.
+func (e *Exchange) EnablePaperTrading(config PaperConfig) *PaperTransport {
+	if config.User == "" {
+		config.User = e.accountAddr
+		if config.User == "" {
+			config.User = e.vault
+		}
+	}
+
+	paper := NewPaperTransport(e.transport, e.info, config)
+	e.SetTransport(paper)
+	return paper
+}
+
+// SetPosition replaces the position in coin, as if it had been opened at
+// entryPx without paying fees. A zero szi closes it. A zero leverage value
+// keeps the leverage of the coin.
+func (p *PaperTransport) SetPosition(coin string, szi, entryPx float64, leverage Leverage) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pos := p.position(coin)
+	pos.szi = szi
+	pos.entryPx = entryPx
+	if leverage.Value != 0 {
+		pos.leverage = leverage.Value
+		pos.isolated = leverage.Type == "isolated"
+	}
+}
+
+func (p *PaperTransport) Info(ctx context.Context, request any) ([]byte, error) {
+	req, ok := request.(map[string]any)
+	if !ok || !p.isUser(req["user"]) {
+		return p.market.Info(ctx, request)
+	}
+
+	switch req["type"] {
+	case "clearinghouseState", "openOrders", "frontendOpenOrders", "userFills":
+	default:
+		return p.market.Info(ctx, request)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.sweep(ctx); err != nil {
+		return nil, err
+	}
+
+	switch req["type"] {
+	case "clearinghouseState":
+		return json.Marshal(p.userState())
+	case "userFills":
+		return json.Marshal(p.userFills())
+	default:
+		return json.Marshal(p.openOrders())
+	}
+}
+
+func (p *PaperTransport) Exchange(ctx context.Context, payload any) ([]byte, error) {
+	body, ok := payload.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("paper trading: unexpected payload %T", payload)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.loadFees(ctx); err != nil {
+		return nil, err
+	}
+	if err := p.sweep(ctx); err != nil {
+		return nil, err
+	}
+
+	switch action := body["action"].(type) {
+	case OrderAction:
+		statuses, err := p.placeOrders(ctx, action.Orders, Grouping(action.Grouping))
+		if err != nil {
+			return nil, err
+		}
+		return paperResponse("order", map[string]any{"statuses": statuses})
+	case ModifyAction:
+		statuses, err := p.modifyOrders(ctx, []ModifyWire{{Oid: action.Oid, Order: action.Order}})
+		if err != nil {
+			return nil, err
+		}
+		return paperResponse("order", map[string]any{"statuses": statuses})
+	case BatchModifyAction:
+		statuses, err := p.modifyOrders(ctx, action.Modifies)
+		if err != nil {
+			return nil, err
+		}
+		return paperResponse("order", map[string]any{"statuses": statuses})
+	case CancelAction:
+		statuses := make([]any, len(action.Cancels))
+		for i, cancel := range action.Cancels {
+			statuses[i] = p.cancel(cancel.Asset, func(o *paperOrder) bool {
+				return o.oid == cancel.OrderID
+			})
+		}
+		return paperResponse("cancel", map[string]any{"statuses": statuses})
+	case CancelByCloidAction:
+		statuses := make([]any, len(action.Cancels))
+		for i, cancel := range action.Cancels {
+			statuses[i] = p.cancel(cancel.Asset, func(o *paperOrder) bool {
+				return o.cloid != nil && *o.cloid == cancel.ClientID
+			})
+		}
+		return paperResponse("cancel", map[string]any{"statuses": statuses})
+	case UpdateLeverageAction:
+		return p.updateLeverage(action)
+	default:
+		return json.Marshal(map[string]any{
+			"status":   "err",
+			"response": fmt.Sprintf("paper trading does not support %s actions", actionType(action)),
+		})
+	}
+}
+
+func (p *PaperTransport) isUser(user any) bool {
+	s, ok := user.(string)
+	return ok && strings.EqualFold(s, p.config.User)
+}
+
+func (p *PaperTransport) loadFees(ctx context.Context) error {
+	if p.fees != nil {
+		return nil
+	}
+
+	resp, err := p.market.Info(ctx, map[string]any{
+		"type": "userFees",
+		"user": p.config.User,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fetch user fees: %w", err)
+	}
+
+	var fees UserFees
+	if err := json.Unmarshal(resp, &fees); err != nil {
+		return fmt.Errorf("failed to unmarshal user fees: %w", err)
+	}
+	p.fees = &fees
+	return nil
+}
+
+func (p *PaperTransport) updateLeverage(action UpdateLeverageAction) ([]byte, error) {
+	coin, ok := p.assetCoin(action.Asset)
+	if !ok {
+		return json.Marshal(map[string]any{
+			"status":   "err",
+			"response": fmt.Sprintf("unknown asset %d", action.Asset),
+		})
+	}
+
+	leverage := action.Leverage
+	pos := p.position(coin)
+	pos.leverage = leverage.Value
+	pos.isolated = leverage.Type == "isolated"
+	return json.Marshal(map[string]any{
+		"status":   "ok",
+		"response": map[string]any{"type": "default"},
+	})
+}
+
+// paperResponse builds a successful /exchange response
+func paperResponse(typ string, data any) ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"status": "ok",
+		"response": map[string]any{
+			"type": typ,
+			"data": data,
+		},
+	})
+}
+
+// actionType returns the type field of a signed action
+func actionType(action any) string {
+	data, err := json.Marshal(action)
+	if err != nil {
+		return fmt.Sprintf("%T", action)
+	}
+
+	var typed struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &typed); err != nil || typed.Type == "" {
+		return fmt.Sprintf("%T", action)
+	}
+	return typed.Type
+}
@@ -0,0 +1,630 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const paperFillHash = "0x0000000000000000000000000000000000000000000000000000000000000000"
+
+// paperOrder is an order known to the paper engine: resting, a trigger
+// waiting for its price, or a TP/SL leg waiting for its parent to fill
+type paperOrder struct {
+	oid        int64
+	cloid      *Cloid
+	coin       string
+	asset      int
+	isBuy      bool
+	limitPx    float64
+	sz         float64
+	reduceOnly bool
+	tif        Tif
+	trigger    bool
+	triggerPx  float64
+	isMarket   bool
+	tpsl       string
+	// parent is the oid of the normalTpsl entry the leg waits for, 0 if none
+	parent    int64
+	timestamp int64
+}
+
+type paperPosition struct {
+	szi      float64
+	entryPx  float64
+	leverage int
+	isolated bool
+}
+
+func paperError(asset int, format string, args ...any) map[string]any {
+	return map[string]any{"error": fmt.Sprintf(format, args...) + fmt.Sprintf(" asset=%d", asset)}
+}
+
+// paperFloat formats x the way the API does, without float noise
+func paperFloat(x float64) string {
+	x = math.Round(x*1e8) / 1e8
+	if x == 0 {
+		// avoid "-0"
+		x = 0
+	}
+	return strconv.FormatFloat(x, 'f', -1, 64)
+}
+
+func paperSize(x float64) float64 {
+	return math.Round(x*1e8) / 1e8
+}
+
+// assetCoin returns the perp coin of an asset id
+func (p *PaperTransport) assetCoin(asset int) (string, bool) {
+	for coin, a := range p.info.coinToAsset {
+		if a == asset && !isSpotAsset(a) {
+			return coin, true
+		}
+	}
+	return "", false
+}
+
+func (p *PaperTransport) position(coin string) *paperPosition {
+	pos, ok := p.positions[coin]
+	if !ok {
+		pos = &paperPosition{leverage: p.config.DefaultLeverage}
+		p.positions[coin] = pos
+	}
+	return pos
+}
+
+// reducible returns how much of sz closes the current position
+func (p *PaperTransport) reducible(coin string, isBuy bool, sz float64) float64 {
+	szi := p.position(coin).szi
+	if (isBuy && szi < 0) || (!isBuy && szi > 0) {
+		return math.Min(sz, abs(szi))
+	}
+	return 0
+}
+
+func (p *PaperTransport) mark(coin string, pos *paperPosition) float64 {
+	if mid, ok := p.mids[coin]; ok {
+		return mid
+	}
+	return pos.entryPx
+}
+
+// accountValue returns the balance plus unrealized pnl and the margin used
+func (p *PaperTransport) accountValue() (value, marginUsed float64) {
+	value = p.balance
+	for coin, pos := range p.positions {
+		if pos.szi == 0 {
+			continue
+		}
+		mark := p.mark(coin, pos)
+		value += pos.szi * (mark - pos.entryPx)
+		marginUsed += abs(pos.szi) * mark / float64(pos.leverage)
+	}
+	return value, marginUsed
+}
+
+func (p *PaperTransport) findOrder(match func(o *paperOrder) bool) *paperOrder {
+	for _, o := range p.orders {
+		if match(o) {
+			return o
+		}
+	}
+	return nil
+}
+
+func (p *PaperTransport) removeOrder(order *paperOrder) {
+	for i, o := range p.orders {
+		if o == order {
+			p.orders = append(p.orders[:i], p.orders[i+1:]...)
+			return
+		}
+	}
+}
+
+func (p *PaperTransport) book(ctx context.Context, coin string) (*L2Book, error) {
+	resp, err := p.market.Info(ctx, map[string]any{
+		"type": "l2Book",
+		"coin": coin,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch L2 snapshot: %w", err)
+	}
+
+	var book L2Book
+	if err := json.Unmarshal(resp, &book); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal L2 snapshot: %w", err)
+	}
+	return &book, nil
+}
+
+// refreshMids fetches the mids of every dex the paper account trades on
+func (p *PaperTransport) refreshMids(ctx context.Context, coins map[string]struct{}) error {
+	dexes := make(map[string]struct{})
+	for coin := range coins {
+		dexes[perpDexOf(coin)] = struct{}{}
+	}
+
+	for dex := range dexes {
+		req := map[string]any{"type": "allMids"}
+		if dex != "" {
+			req["dex"] = dex
+		}
+
+		resp, err := p.market.Info(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to fetch mids: %w", err)
+		}
+
+		var mids map[string]string
+		if err := json.Unmarshal(resp, &mids); err != nil {
+			return fmt.Errorf("failed to unmarshal mids: %w", err)
+		}
+		for coin, mid := range mids {
+			p.mids[coin] = parseFloat(mid)
+		}
+	}
+	return nil
+}
+
+func (p *PaperTransport) newOrder(wire OrderWire) (*paperOrder, map[string]any) {
+	coin, ok := p.assetCoin(wire.Asset)
+	if !ok {
+		return nil, paperError(wire.Asset, "Paper trading only supports perp orders.")
+	}
+
+	order := &paperOrder{
+		cloid:      wire.Cloid,
+		coin:       coin,
+		asset:      wire.Asset,
+		isBuy:      wire.IsBuy,
+		limitPx:    parseFloat(wire.LimitPx),
+		sz:         parseFloat(wire.Size),
+		reduceOnly: wire.ReduceOnly,
+		timestamp:  time.Now().UnixMilli(),
+	}
+	switch {
+	case wire.OrderType.Limit != nil:
+		order.tif = Tif(wire.OrderType.Limit.Tif)
+	case wire.OrderType.Trigger != nil:
+		order.trigger = true
+		order.triggerPx = parseFloat(wire.OrderType.Trigger.TriggerPx)
+		order.isMarket = wire.OrderType.Trigger.IsMarket
+		order.tpsl = wire.OrderType.Trigger.Tpsl
+	}
+
+	if order.sz <= 0 || order.limitPx <= 0 {
+		return nil, paperError(wire.Asset, "Order has invalid size or price.")
+	}
+	if !order.reduceOnly && order.limitPx*order.sz < DefaultMinNotional {
+		return nil, paperError(wire.Asset, "Order must have minimum value of $10.")
+	}
+
+	order.oid = p.nextOid
+	p.nextOid++
+	return order, nil
+}
+
+func (p *PaperTransport) placeOrders(
+	ctx context.Context,
+	wires []OrderWire,
+	grouping Grouping,
+) ([]any, error) {
+	statuses := make([]any, len(wires))
+	parent := int64(-1)
+
+	for i, wire := range wires {
+		order, rejected := p.newOrder(wire)
+		if rejected != nil {
+			statuses[i] = rejected
+			continue
+		}
+
+		switch {
+		case grouping == GroupingNormalTpsl && i > 0 && order.trigger:
+			if parent < 0 {
+				statuses[i] = paperError(order.asset, "Parent order was rejected.")
+				continue
+			}
+			order.parent = parent
+			p.orders = append(p.orders, order)
+			statuses[i] = OrderStatusWaitingForFill
+		case grouping == GroupingPositionTpsl && order.trigger:
+			p.orders = append(p.orders, order)
+			statuses[i] = OrderStatusWaitingForTrigger
+		default:
+			status, err := p.submit(ctx, order)
+			if err != nil {
+				return nil, err
+			}
+			statuses[i] = status
+			if i == 0 {
+				if _, ok := status["error"]; !ok {
+					parent = order.oid
+				}
+			}
+		}
+	}
+	return statuses, nil
+}
+
+func (p *PaperTransport) modifyOrders(ctx context.Context, modifies []ModifyWire) ([]any, error) {
+	statuses := make([]any, len(modifies))
+	for i, modify := range modifies {
+		existing := p.findOrder(func(o *paperOrder) bool {
+			if oid, ok := modify.Oid.Oid(); ok {
+				return o.oid == oid
+			}
+			cloid, ok := modify.Oid.Cloid()
+			return ok && o.cloid != nil && *o.cloid == cloid
+		})
+		if existing == nil {
+			statuses[i] = paperError(modify.Order.Asset, "Cannot modify canceled or filled order.")
+			continue
+		}
+		p.removeOrder(existing)
+
+		order, rejected := p.newOrder(modify.Order)
+		if rejected != nil {
+			statuses[i] = rejected
+			continue
+		}
+		status, err := p.submit(ctx, order)
+		if err != nil {
+			return nil, err
+		}
+		statuses[i] = status
+	}
+	return statuses, nil
+}
+
+func (p *PaperTransport) cancel(asset int, match func(o *paperOrder) bool) any {
+	order := p.findOrder(func(o *paperOrder) bool {
+		return o.asset == asset && match(o)
+	})
+	if order == nil {
+		return paperError(asset, "Order was never placed, already canceled, or filled.")
+	}
+	p.removeOrder(order)
+	return "success"
+}
+
+// submit matches a new order against the book and rests what is left
+func (p *PaperTransport) submit(ctx context.Context, order *paperOrder) (map[string]any, error) {
+	if order.trigger {
+		p.orders = append(p.orders, order)
+		return restingStatus(order), nil
+	}
+
+	if order.reduceOnly {
+		order.sz = p.reducible(order.coin, order.isBuy, order.sz)
+		if order.sz == 0 {
+			return paperError(order.asset, "Reduce only order would increase position."), nil
+		}
+	}
+
+	book, err := p.book(ctx, order.coin)
+	if err != nil {
+		return nil, err
+	}
+	var opposite []Level
+	if len(book.Levels) == 2 {
+		opposite = book.Levels[1]
+		if !order.isBuy {
+			opposite = book.Levels[0]
+		}
+	}
+
+	crosses := func(px float64) bool {
+		if order.isBuy {
+			return px <= order.limitPx
+		}
+		return px >= order.limitPx
+	}
+
+	if order.tif == TifAlo && len(opposite) > 0 && crosses(opposite[0].Px) {
+		bid, ask := "", ""
+		if len(book.Levels[0]) > 0 {
+			bid = paperFloat(book.Levels[0][0].Px)
+		}
+		if len(book.Levels[1]) > 0 {
+			ask = paperFloat(book.Levels[1][0].Px)
+		}
+		return paperError(
+			order.asset,
+			"Post only order would have immediately matched, bbo was %s@%s.", bid, ask,
+		), nil
+	}
+
+	if !order.reduceOnly {
+		pos := p.position(order.coin)
+		opening := order.sz - p.reducible(order.coin, order.isBuy, order.sz)
+		value, marginUsed := p.accountValue()
+		if opening*order.limitPx/float64(pos.leverage) > value-marginUsed {
+			return paperError(order.asset, "Insufficient margin to place order."), nil
+		}
+	}
+
+	var filledSz, filledNtl float64
+	if order.tif != TifAlo {
+		for _, level := range opposite {
+			if order.sz == 0 || !crosses(level.Px) {
+				break
+			}
+			sz := math.Min(order.sz, level.Sz)
+			p.fill(order, level.Px, sz, true)
+			order.sz = paperSize(order.sz - sz)
+			filledSz += sz
+			filledNtl += sz * level.Px
+		}
+	}
+
+	switch {
+	case order.sz > 0 && order.tif == TifIoc && filledSz == 0:
+		return paperError(order.asset, "Order could not immediately match against any resting orders."), nil
+	case order.sz > 0 && order.tif != TifIoc:
+		p.orders = append(p.orders, order)
+		return restingStatus(order), nil
+	default:
+		filled := map[string]any{
+			"totalSz": paperFloat(filledSz),
+			"avgPx":   paperFloat(filledNtl / filledSz),
+			"oid":     order.oid,
+		}
+		if order.cloid != nil {
+			filled["cloid"] = order.cloid.String()
+		}
+		return map[string]any{"filled": filled}, nil
+	}
+}
+
+func restingStatus(order *paperOrder) map[string]any {
+	resting := map[string]any{"oid": order.oid}
+	if order.cloid != nil {
+		resting["cloid"] = order.cloid.String()
+	}
+	return map[string]any{"resting": resting}
+}
+
+// sweep fills resting orders the mids have crossed, fires triggers and
+// releases TP/SL legs whose parent filled
+func (p *PaperTransport) sweep(ctx context.Context) error {
+	coins := make(map[string]struct{})
+	for _, o := range p.orders {
+		coins[o.coin] = struct{}{}
+	}
+	for coin, pos := range p.positions {
+		if pos.szi != 0 {
+			coins[coin] = struct{}{}
+		}
+	}
+	if len(coins) == 0 {
+		return nil
+	}
+	if err := p.refreshMids(ctx, coins); err != nil {
+		return err
+	}
+
+	for _, o := range append([]*paperOrder(nil), p.orders...) {
+		if o.parent != 0 {
+			parent := o.parent
+			if p.filled[parent] > 0 {
+				o.parent = 0
+			} else if p.findOrder(func(other *paperOrder) bool { return other.oid == parent }) == nil {
+				p.removeOrder(o)
+			}
+			continue
+		}
+
+		mid, ok := p.mids[o.coin]
+		if !ok {
+			continue
+		}
+
+		if o.trigger {
+			if !o.triggered(mid) {
+				continue
+			}
+			p.removeOrder(o)
+			o.trigger = false
+			o.tif = TifGtc
+			if o.isMarket {
+				o.tif = TifIoc
+			}
+			if _, err := p.submit(ctx, o); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if (o.isBuy && mid > o.limitPx) || (!o.isBuy && mid < o.limitPx) {
+			continue
+		}
+		p.removeOrder(o)
+		sz := o.sz
+		if o.reduceOnly {
+			sz = p.reducible(o.coin, o.isBuy, sz)
+		}
+		if sz > 0 {
+			p.fill(o, o.limitPx, sz, false)
+		}
+	}
+	return nil
+}
+
+func (o *paperOrder) triggered(mid float64) bool {
+	// A buy tp triggers when the price falls to it, a buy sl when the price
+	// rises to it, and the other way around for sells
+	falling := o.isBuy == (o.tpsl == "tp")
+	if falling {
+		return mid <= o.triggerPx
+	}
+	return mid >= o.triggerPx
+}
+
+// fill applies a fill of sz at px to the position and balance
+func (p *PaperTransport) fill(order *paperOrder, px, sz float64, crossed bool) {
+	pos := p.position(order.coin)
+	start := pos.szi
+	signed := sz
+	if !order.isBuy {
+		signed = -sz
+	}
+	end := paperSize(start + signed)
+
+	var closedPnl float64
+	if closing := p.reducible(order.coin, order.isBuy, sz); closing > 0 {
+		closedPnl = closing * (px - pos.entryPx)
+		if start < 0 {
+			closedPnl = -closedPnl
+		}
+	}
+
+	switch {
+	case end == 0:
+		pos.entryPx = 0
+	case start == 0 || (start > 0) != (end > 0):
+		pos.entryPx = px
+	case abs(end) > abs(start):
+		pos.entryPx = (pos.entryPx*abs(start) + px*sz) / abs(end)
+	}
+	pos.szi = end
+
+	rate := p.fees.UserAddRate
+	if crossed {
+		rate = p.fees.UserCrossRate
+	}
+	fee := px * sz * parseFloat(rate)
+	p.balance += closedPnl - fee
+	p.filled[order.oid] += sz
+
+	side := string(OrderSideBid)
+	if !order.isBuy {
+		side = string(OrderSideAsk)
+	}
+	fill := Fill{
+		ClosedPnl:     paperFloat(closedPnl),
+		Coin:          order.coin,
+		Crossed:       crossed,
+		Dir:           fillDir(start, end),
+		Hash:          paperFillHash,
+		Oid:           order.oid,
+		Price:         paperFloat(px),
+		Side:          side,
+		StartPosition: paperFloat(start),
+		Size:          paperFloat(sz),
+		Time:          time.Now().UnixMilli(),
+		Fee:           paperFloat(fee),
+		FeeToken:      "USDC",
+		Tid:           p.nextTid,
+	}
+	if order.cloid != nil {
+		cloid := order.cloid.String()
+		fill.Cloid = &cloid
+	}
+	p.nextTid++
+	p.fills = append(p.fills, fill)
+}
+
+func fillDir(start, end float64) string {
+	switch {
+	case start >= 0 && end >= 0:
+		if end > start {
+			return "Open Long"
+		}
+		return "Close Long"
+	case start <= 0 && end <= 0:
+		if end < start {
+			return "Open Short"
+		}
+		return "Close Short"
+	case start > 0:
+		return "Long > Short"
+	default:
+		return "Short > Long"
+	}
+}
+
+func (p *PaperTransport) userState() *UserState {
+	value, marginUsed := p.accountValue()
+
+	var totalNtl, rawUsd float64
+	rawUsd = value
+	state := &UserState{AssetPositions: []AssetPosition{}}
+	for coin, pos := range p.positions {
+		if pos.szi == 0 {
+			continue
+		}
+
+		mark := p.mark(coin, pos)
+		positionValue := abs(pos.szi) * mark
+		upnl := pos.szi * (mark - pos.entryPx)
+		margin := positionValue / float64(pos.leverage)
+		totalNtl += positionValue
+		rawUsd -= pos.szi * mark
+
+		leverageType := "cross"
+		if pos.isolated {
+			leverageType = "isolated"
+		}
+		entryPx := paperFloat(pos.entryPx)
+		state.AssetPositions = append(state.AssetPositions, AssetPosition{
+			Type: "oneWay",
+			Position: Position{
+				Coin:           coin,
+				EntryPx:        &entryPx,
+				Leverage:       Leverage{Type: leverageType, Value: pos.leverage},
+				MarginUsed:     paperFloat(margin),
+				PositionValue:  paperFloat(positionValue),
+				ReturnOnEquity: paperFloat(upnl / (abs(pos.szi) * pos.entryPx / float64(pos.leverage))),
+				Szi:            paperFloat(pos.szi),
+				UnrealizedPnl:  paperFloat(upnl),
+			},
+		})
+	}
+	sort.Slice(state.AssetPositions, func(i, j int) bool {
+		return state.AssetPositions[i].Position.Coin < state.AssetPositions[j].Position.Coin
+	})
+
+	summary := MarginSummary{
+		AccountValue:    paperFloat(value),
+		TotalMarginUsed: paperFloat(marginUsed),
+		TotalNtlPos:     paperFloat(totalNtl),
+		TotalRawUsd:     paperFloat(rawUsd),
+	}
+	state.MarginSummary = summary
+	state.CrossMarginSummary = summary
+	state.Withdrawable = paperFloat(math.Max(0, value-marginUsed))
+	return state
+}
+
+func (p *PaperTransport) openOrders() []OpenOrder {
+	orders := make([]OpenOrder, 0, len(p.orders))
+	for _, o := range p.orders {
+		side := string(OrderSideBid)
+		if !o.isBuy {
+			side = string(OrderSideAsk)
+		}
+		orders = append(orders, OpenOrder{
+			Coin:      o.coin,
+			LimitPx:   o.limitPx,
+			Oid:       o.oid,
+			Side:      side,
+			Size:      o.sz,
+			Timestamp: o.timestamp,
+		})
+	}
+	return orders
+}
+
+// userFills returns the fills newest first, like the API
+func (p *PaperTransport) userFills() []Fill {
+	fills := make([]Fill, len(p.fills))
+	for i, fill := range p.fills {
+		fills[len(p.fills)-1-i] = fill
+	}
+	return fills
+}
@@ -0,0 +1,209 @@
+package hyperliquid
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// paperMarket serves a one level book around a movable ETH mid
+type paperMarket struct {
+	mid float64
+}
+
+func (m *paperMarket) transport(t *testing.T) *memoryTransport {
+	return &memoryTransport{
+		infoResponse: func(req map[string]any) ([]byte, error) {
+			switch req["type"] {
+			case "allMids":
+				return []byte(fmt.Sprintf(`{"ETH":"%v"}`, m.mid)), nil
+			case "l2Book":
+				return []byte(fmt.Sprintf(
+					`{"coin":"ETH","time":1,"levels":[[{"px":"%v","sz":"2","n":1}],[{"px":"%v","sz":"2","n":1}]]}`,
+					m.mid-1, m.mid+1,
+				)), nil
+			case "userFees":
+				return []byte(`{"userCrossRate":"0.0005","userAddRate":"0.0001"}`), nil
+			}
+			t.Fatalf("unexpected info request %v", req)
+			return nil, nil
+		},
+		exchangeResponse: func(payload map[string]any) ([]byte, error) {
+			t.Fatalf("paper trading sent an action: %v", payload)
+			return nil, nil
+		},
+	}
+}
+
+func TestPaperTransport_Orders(t *testing.T) {
+	market := &paperMarket{mid: 4000}
+	exchange := newMemoryExchange(t, market.transport(t))
+	exchange.EnablePaperTrading(PaperConfig{Balance: 10000})
+
+	status, err := exchange.Order(limitOrder("ETH", true, 4010, 3), nil)
+	require.NoError(t, err)
+	require.NotNil(t, status.Resting)
+	assert.Equal(t, int64(1), status.Resting.Oid)
+
+	// 2 of 3 fill against the ask, the rest rests at 4010 and fills on the
+	// next sweep since the mid is below it
+	state, err := exchange.info.UserState(exchange.accountAddr)
+	require.NoError(t, err)
+	require.Len(t, state.AssetPositions, 1)
+	pos := state.AssetPositions[0].Position
+	assert.Equal(t, "3", pos.Szi)
+	assert.Equal(t, "4004", *pos.EntryPx)
+	assert.Equal(t, Leverage{Type: "cross", Value: 20}, pos.Leverage)
+	assert.Equal(t, "-12", pos.UnrealizedPnl)
+	// fees: 2*4001*0.0005 + 1*4010*0.0001
+	assert.Equal(t, "9983.598", state.MarginSummary.AccountValue)
+
+	status, err = exchange.Order(reduceOnlyOrder(limitOrder("ETH", false, 4100, 3)), nil)
+	require.NoError(t, err)
+	require.NotNil(t, status.Resting)
+
+	orders, err := exchange.info.OpenOrders(exchange.accountAddr)
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	assert.Equal(t, OpenOrder{Coin: "ETH", LimitPx: 4100, Oid: 2, Side: "A", Size: 3, Timestamp: orders[0].Timestamp}, orders[0])
+
+	market.mid = 4100
+	fills, err := exchange.info.UserFills(exchange.accountAddr)
+	require.NoError(t, err)
+	require.Len(t, fills, 3)
+	assert.Equal(t, "Close Long", fills[0].Dir)
+	assert.Equal(t, "288", fills[0].ClosedPnl)
+	assert.Equal(t, "1.23", fills[0].Fee)
+	assert.False(t, fills[0].Crossed)
+	assert.Equal(t, "Open Long", fills[2].Dir)
+	assert.True(t, fills[2].Crossed)
+
+	state, err = exchange.info.UserState(exchange.accountAddr)
+	require.NoError(t, err)
+	assert.Empty(t, state.AssetPositions)
+	assert.Equal(t, "10282.368", state.MarginSummary.AccountValue)
+}
+
+func TestPaperTransport_Rejects(t *testing.T) {
+	tests := []struct {
+		name  string
+		order CreateOrderRequest
+		want  string
+	}{
+		{
+			name:  "min notional",
+			order: limitOrder("ETH", true, 4000, 0.001),
+			want:  "Order must have minimum value of $10. asset=1",
+		},
+		{
+			name: "post only crossing",
+			order: CreateOrderRequest{
+				Coin: "ETH", IsBuy: true, Price: 4001, Size: 1,
+				OrderType: OrderType{Limit: &LimitOrderType{Tif: TifAlo}},
+			},
+			want: "Post only order would have immediately matched, bbo was 3999@4001. asset=1",
+		},
+		{
+			name: "ioc without liquidity",
+			order: CreateOrderRequest{
+				Coin: "ETH", IsBuy: true, Price: 3990, Size: 1,
+				OrderType: OrderType{Limit: &LimitOrderType{Tif: TifIoc}},
+			},
+			want: "Order could not immediately match against any resting orders. asset=1",
+		},
+		{
+			name:  "reduce only without position",
+			order: reduceOnlyOrder(limitOrder("ETH", false, 4000, 1)),
+			want:  "Reduce only order would increase position. asset=1",
+		},
+		{
+			name:  "insufficient margin",
+			order: limitOrder("ETH", true, 3990, 100),
+			want:  "Insufficient margin to place order. asset=1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			market := &paperMarket{mid: 4000}
+			exchange := newMemoryExchange(t, market.transport(t))
+			exchange.EnablePaperTrading(PaperConfig{Balance: 1000})
+
+			_, err := exchange.Order(tc.order, nil)
+			assert.EqualError(t, err, tc.want)
+		})
+	}
+
+	market := &paperMarket{mid: 4000}
+	exchange := newMemoryExchange(t, market.transport(t))
+	exchange.EnablePaperTrading(PaperConfig{Balance: 1000})
+
+	_, err := exchange.Cancel("ETH", 42)
+	assert.EqualError(t, err, "Order was never placed, already canceled, or filled. asset=1")
+}
+
+func TestPaperTransport_Bracket(t *testing.T) {
+	market := &paperMarket{mid: 4000}
+	exchange := newMemoryExchange(t, market.transport(t))
+	exchange.EnablePaperTrading(PaperConfig{
+		Balance: 10000,
+		Fees:    &UserFees{UserCrossRate: "0", UserAddRate: "0"},
+	})
+
+	res, err := exchange.BracketOrder(BracketOrderRequest{
+		Entry:      limitOrder("ETH", true, 3900, 1),
+		TakeProfit: &TpslLeg{TriggerPx: 4200, LimitPx: 4150, IsMarket: true},
+		StopLoss:   &TpslLeg{TriggerPx: 3800, IsMarket: true, LimitPx: 3700},
+	}, nil)
+	require.NoError(t, err)
+	require.NotNil(t, res.Entry.Resting)
+	assert.Equal(t, OrderStatusWaitingForFill, res.TakeProfit.Waiting)
+	assert.Equal(t, OrderStatusWaitingForFill, res.StopLoss.Waiting)
+
+	// the tp price is reached before the entry fills: nothing happens
+	market.mid = 4200
+	state, err := exchange.info.UserState(exchange.accountAddr)
+	require.NoError(t, err)
+	assert.Empty(t, state.AssetPositions)
+
+	market.mid = 3900
+	state, err = exchange.info.UserState(exchange.accountAddr)
+	require.NoError(t, err)
+	require.Len(t, state.AssetPositions, 1)
+
+	market.mid = 4200
+	_, err = exchange.info.UserState(exchange.accountAddr)
+	require.NoError(t, err)
+
+	// the tp filled against the bid, the sl is left reduce only
+	fills, err := exchange.info.UserFills(exchange.accountAddr)
+	require.NoError(t, err)
+	require.Len(t, fills, 2)
+	assert.Equal(t, "4199", fills[0].Price)
+	assert.Equal(t, "299", fills[0].ClosedPnl)
+
+	market.mid = 3800
+	state, err = exchange.info.UserState(exchange.accountAddr)
+	require.NoError(t, err)
+	assert.Empty(t, state.AssetPositions)
+	assert.Equal(t, "10299", state.MarginSummary.AccountValue)
+
+	orders, err := exchange.info.OpenOrders(exchange.accountAddr)
+	require.NoError(t, err)
+	assert.Empty(t, orders)
+}
+
+func TestPaperTransport_UnsupportedAction(t *testing.T) {
+	market := &paperMarket{mid: 4000}
+	exchange := newMemoryExchange(t, market.transport(t))
+	paper := exchange.EnablePaperTrading(PaperConfig{Fees: &UserFees{}})
+
+	resp, err := paper.Exchange(context.Background(), map[string]any{
+		"action": ScheduleCancelAction{Type: "scheduleCancel"},
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"status":"err","response":"paper trading does not support scheduleCancel actions"}`, string(resp))
+}
@@ -24,23 +24,32 @@ func addressToBytes(address string) []byte {
 	return bytes
 }
 
-// actionHash implements the same logic as Python's action_hash function
-func actionHash(action any, vaultAddress string, nonce int64, expiresAfter *int64) []byte {
-	// Pack action using msgpack (like Python's msgpack.packb)
+// packAction encodes action with msgpack like Python's msgpack.packb. Struct
+// fields are written in declaration order and map keys sorted.
+func packAction(action any) ([]byte, error) {
 	var buf bytes.Buffer
 	enc := msgpack.NewEncoder(&buf)
 	enc.SetSortMapKeys(true)
 	enc.UseCompactInts(true)
 
-	err := enc.Encode(action)
+	if err := enc.Encode(action); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ActionHash returns the hash an L1 action is signed over, implementing the
+// same logic as Python's action_hash function. Pass a RawAction to hash an
+// action as it was sent over the wire.
+func ActionHash(action any, vaultAddress string, nonce int64, expiresAfter *int64) ([]byte, error) {
+	data, err := packAction(action)
 	if err != nil {
-		panic(fmt.Sprintf("failed to marshal action: %v", err))
+		return nil, fmt.Errorf("failed to marshal action: %w", err)
 	}
-	data := buf.Bytes()
 
 	// Add nonce as 8 bytes big endian
 	if nonce < 0 {
-		panic(fmt.Sprintf("nonce cannot be negative: %d", nonce))
+		return nil, fmt.Errorf("nonce cannot be negative: %d", nonce)
 	}
 	nonceBytes := make([]byte, 8)
 	binary.BigEndian.PutUint64(nonceBytes, uint64(nonce))
@@ -57,7 +66,7 @@ func actionHash(action any, vaultAddress string, nonce int64, expiresAfter *int6
 	// Add expires_after if provided
 	if expiresAfter != nil {
 		if *expiresAfter < 0 {
-			panic(fmt.Sprintf("expiresAfter cannot be negative: %d", *expiresAfter))
+			return nil, fmt.Errorf("expiresAfter cannot be negative: %d", *expiresAfter)
 		}
 		data = append(data, 0x00)
 		expiresAfterBytes := make([]byte, 8)
@@ -66,9 +75,7 @@ func actionHash(action any, vaultAddress string, nonce int64, expiresAfter *int6
 	}
 
 	// Return keccak256 hash
-	hash := crypto.Keccak256(data)
-	// fmt.Printf("go action hash: %s\n", hex.EncodeToString(hash))
-	return hash
+	return crypto.Keccak256(data), nil
 }
 
 // constructPhantomAgent implements the same logic as Python's construct_phantom_agent
@@ -112,9 +119,9 @@ func l1Payload(phantomAgent map[string]any) apitypes.TypedData {
 
 // SignatureResult represents the structured signature result
 type SignatureResult struct {
-	R string `json:"r"`
-	S string `json:"s"`
-	V int    `json:"v"`
+	R string `json:"r" msgpack:"r"`
+	S string `json:"s" msgpack:"s"`
+	V int    `json:"v" msgpack:"v"`
 }
 
 // signInner implements the same logic as Python's sign_inner
@@ -165,7 +172,10 @@ func SignL1Action(
 	isMainnet bool,
 ) (SignatureResult, error) {
 	// Step 1: Create action hash
-	hash := actionHash(action, vaultAddress, timestamp, expiresAfter)
+	hash, err := ActionHash(action, vaultAddress, timestamp, expiresAfter)
+	if err != nil {
+		return SignatureResult{}, err
+	}
 
 	// Step 2: Construct phantom agent
 	phantomAgent := constructPhantomAgent(hash, isMainnet)
@@ -336,23 +346,120 @@ func SignConvertToMultiSigUserAction(
 	return SignL1Action(privateKey, action, "", timestamp, nil, isMainnet)
 }
 
-// SignMultiSigAction signs multi-signature action
+// SignMultiSigL1ActionPayload implements the same logic as Python's
+// sign_multi_sig_l1_action_payload. It is the signature an authorized user
+// contributes to a multi-sig action.
+func SignMultiSigL1ActionPayload(
+	privateKey *ecdsa.PrivateKey,
+	action any,
+	multiSigUser, outerSigner string,
+	vaultAddress string,
+	timestamp int64,
+	expiresAfter *int64,
+	isMainnet bool,
+) (SignatureResult, error) {
+	return SignL1Action(
+		privateKey,
+		multiSigEnvelope(action, multiSigUser, outerSigner),
+		vaultAddress,
+		timestamp,
+		expiresAfter,
+		isMainnet,
+	)
+}
+
+// SignMultiSigUserSignedActionPayload implements the same logic as Python's
+// sign_multi_sig_user_signed_action_payload. It is the signature an
+// authorized user contributes to a multi-sig action of a user signed type.
+func SignMultiSigUserSignedActionPayload(
+	privateKey *ecdsa.PrivateKey,
+	action map[string]any,
+	payloadTypes []apitypes.Type,
+	primaryType string,
+	multiSigUser, outerSigner string,
+	isMainnet bool,
+) (SignatureResult, error) {
+	envelope, envelopeTypes := multiSigUserSignedEnvelope(
+		action,
+		payloadTypes,
+		multiSigUser,
+		outerSigner,
+	)
+	return SignUserSignedAction(privateKey, envelope, envelopeTypes, primaryType, isMainnet)
+}
+
+// multiSigUserSignedEnvelope adds the multi-sig user and outer signer to a
+// user signed action and its EIP-712 type, after hyperliquidChain
+func multiSigUserSignedEnvelope(
+	action map[string]any,
+	payloadTypes []apitypes.Type,
+	multiSigUser, outerSigner string,
+) (map[string]any, []apitypes.Type) {
+	envelope := make(map[string]any, len(action)+2)
+	for k, v := range action {
+		envelope[k] = v
+	}
+	envelope["payloadMultiSigUser"] = strings.ToLower(multiSigUser)
+	envelope["outerSigner"] = strings.ToLower(outerSigner)
+
+	envelopeTypes := make([]apitypes.Type, 0, len(payloadTypes)+2)
+	for _, field := range payloadTypes {
+		envelopeTypes = append(envelopeTypes, field)
+		if field.Name == "hyperliquidChain" {
+			envelopeTypes = append(
+				envelopeTypes,
+				apitypes.Type{Name: "payloadMultiSigUser", Type: "address"},
+				apitypes.Type{Name: "outerSigner", Type: "address"},
+			)
+		}
+	}
+	return envelope, envelopeTypes
+}
+
+// multiSigEnvelope is what authorized users sign for a multi-sig action
+func multiSigEnvelope(action any, multiSigUser, outerSigner string) []any {
+	return []any{strings.ToLower(multiSigUser), strings.ToLower(outerSigner), action}
+}
+
+// multiSigActionWithoutType is a MultiSigAction without its type tag, which
+// is left out of the hash the outer signer signs
+type multiSigActionWithoutType struct {
+	SignatureChainID string            `msgpack:"signatureChainId"`
+	Signatures       []SignatureResult `msgpack:"signatures"`
+	Payload          MultiSigPayload   `msgpack:"payload"`
+}
+
+// SignMultiSigAction implements the same logic as Python's
+// sign_multi_sig_action. It is the signature of the outer signer that submits
+// a multi-sig action with the signatures of the authorized users.
 func SignMultiSigAction(
 	privateKey *ecdsa.PrivateKey,
-	innerAction map[string]any,
-	signers []string,
-	signatures []string,
+	action MultiSigAction,
+	vaultAddress string,
 	timestamp int64,
+	expiresAfter *int64,
 	isMainnet bool,
 ) (SignatureResult, error) {
-	action := map[string]any{
-		"type":       "multiSig",
-		"action":     innerAction,
-		"signers":    signers,
-		"signatures": signatures,
+	hash, err := ActionHash(
+		multiSigActionWithoutType{
+			SignatureChainID: action.SignatureChainID,
+			Signatures:       action.Signatures,
+			Payload:          action.Payload,
+		},
+		vaultAddress,
+		timestamp,
+		expiresAfter,
+	)
+	if err != nil {
+		return SignatureResult{}, err
 	}
 
-	return SignL1Action(privateKey, action, "", timestamp, nil, isMainnet)
+	envelope := map[string]any{
+		"multiSigActionHash": hash,
+		"nonce":              timestamp,
+	}
+	typ := userSignedTypes["multiSig"]
+	return SignUserSignedAction(privateKey, envelope, typ.fields, typ.primaryType, isMainnet)
 }
 
 // Utility function to convert float to USD integer representation
@@ -0,0 +1,426 @@
+package hyperliquid
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// SignatureChainID is the chain id user signed actions are signed for,
+// matching the Python SDK
+const SignatureChainID = "0x66eee"
+
+// RawAction is an action as it was sent over the wire. It packs with the key
+// order of the JSON, so ActionHash and RecoverL1Signer can check what a
+// client signed without knowing the action type. That order is the one the
+// action was signed with as long as structs are sent in field order and maps
+// with sorted keys, as encoding/json does and as this client's actions are;
+// JSON from other encoders may need to be checked against the typed action.
+// Integral numbers are packed as ints and the rest as floats, so float
+// fields holding whole numbers do not round trip.
+type RawAction json.RawMessage
+
+// MarshalJSON returns the action unchanged
+func (a RawAction) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	return a, nil
+}
+
+// UnmarshalJSON keeps a copy of the action
+func (a *RawAction) UnmarshalJSON(data []byte) error {
+	*a = append((*a)[:0], data...)
+	return nil
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder
+func (a RawAction) EncodeMsgpack(enc *msgpack.Encoder) error {
+	dec := json.NewDecoder(bytes.NewReader(a))
+	dec.UseNumber()
+	value, err := decodeOrderedJSON(dec)
+	if err != nil {
+		return fmt.Errorf("failed to decode action: %w", err)
+	}
+	return encodeOrderedJSON(enc, value)
+}
+
+// jsonMember is a key of a JSON object, kept in the order it was sent
+type jsonMember struct {
+	key   string
+	value any
+}
+
+// decodeOrderedJSON decodes JSON keeping the key order of objects
+func decodeOrderedJSON(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return tok, nil
+	}
+
+	switch delim {
+	case '{':
+		var object []jsonMember
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeOrderedJSON(dec)
+			if err != nil {
+				return nil, err
+			}
+			object = append(object, jsonMember{key: keyTok.(string), value: value})
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return object, nil
+	case '[':
+		array := []any{}
+		for dec.More() {
+			value, err := decodeOrderedJSON(dec)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, value)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return array, nil
+	default:
+		return nil, fmt.Errorf("unexpected delimiter %v", delim)
+	}
+}
+
+func encodeOrderedJSON(enc *msgpack.Encoder, value any) error {
+	switch v := value.(type) {
+	case nil:
+		return enc.EncodeNil()
+	case bool:
+		return enc.EncodeBool(v)
+	case string:
+		return enc.EncodeString(v)
+	case json.Number:
+		if strings.ContainsAny(v.String(), ".eE") {
+			f, err := v.Float64()
+			if err != nil {
+				return err
+			}
+			return enc.EncodeFloat64(f)
+		}
+		n, err := v.Int64()
+		if err != nil {
+			return err
+		}
+		return enc.EncodeInt(n)
+	case []any:
+		if err := enc.EncodeArrayLen(len(v)); err != nil {
+			return err
+		}
+		for _, item := range v {
+			if err := encodeOrderedJSON(enc, item); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []jsonMember:
+		if err := enc.EncodeMapLen(len(v)); err != nil {
+			return err
+		}
+		for _, member := range v {
+			if err := enc.EncodeString(member.key); err != nil {
+				return err
+			}
+			if err := encodeOrderedJSON(enc, member.value); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unexpected JSON value %T", value)
+	}
+}
+
+// RecoverL1Signer returns the address that produced signature for an L1
+// action. It takes the same arguments as SignL1Action. A valid signature by
+// another key recovers to another address rather than failing, so compare the
+// result with the expected signer.
+func RecoverL1Signer(
+	action any,
+	vaultAddress string,
+	nonce int64,
+	expiresAfter *int64,
+	signature SignatureResult,
+	isMainnet bool,
+) (string, error) {
+	hash, err := ActionHash(action, vaultAddress, nonce, expiresAfter)
+	if err != nil {
+		return "", err
+	}
+	return recoverSigner(l1Payload(constructPhantomAgent(hash, isMainnet)), signature)
+}
+
+// userSignedType is the EIP-712 type of a user signed action
+type userSignedType struct {
+	primaryType string
+	fields      []apitypes.Type
+}
+
+var userSignedTypes = map[string]userSignedType{
+	"usdSend": {"HyperliquidTransaction:UsdSend", []apitypes.Type{
+		{Name: "hyperliquidChain", Type: "string"},
+		{Name: "destination", Type: "string"},
+		{Name: "amount", Type: "string"},
+		{Name: "time", Type: "uint64"},
+	}},
+	"spotSend": {"HyperliquidTransaction:SpotSend", []apitypes.Type{
+		{Name: "hyperliquidChain", Type: "string"},
+		{Name: "destination", Type: "string"},
+		{Name: "token", Type: "string"},
+		{Name: "amount", Type: "string"},
+		{Name: "time", Type: "uint64"},
+	}},
+	"withdraw3": {"HyperliquidTransaction:Withdraw", []apitypes.Type{
+		{Name: "hyperliquidChain", Type: "string"},
+		{Name: "destination", Type: "string"},
+		{Name: "amount", Type: "string"},
+		{Name: "time", Type: "uint64"},
+	}},
+	"usdClassTransfer": {"HyperliquidTransaction:UsdClassTransfer", []apitypes.Type{
+		{Name: "hyperliquidChain", Type: "string"},
+		{Name: "amount", Type: "string"},
+		{Name: "toPerp", Type: "bool"},
+		{Name: "nonce", Type: "uint64"},
+	}},
+	"sendAsset": {"HyperliquidTransaction:SendAsset", []apitypes.Type{
+		{Name: "hyperliquidChain", Type: "string"},
+		{Name: "destination", Type: "string"},
+		{Name: "sourceDex", Type: "string"},
+		{Name: "destinationDex", Type: "string"},
+		{Name: "token", Type: "string"},
+		{Name: "amount", Type: "string"},
+		{Name: "fromSubAccount", Type: "string"},
+		{Name: "nonce", Type: "uint64"},
+	}},
+	"tokenDelegate": {"HyperliquidTransaction:TokenDelegate", []apitypes.Type{
+		{Name: "hyperliquidChain", Type: "string"},
+		{Name: "validator", Type: "address"},
+		{Name: "wei", Type: "uint64"},
+		{Name: "isUndelegate", Type: "bool"},
+		{Name: "nonce", Type: "uint64"},
+	}},
+	"approveAgent": {"HyperliquidTransaction:ApproveAgent", []apitypes.Type{
+		{Name: "hyperliquidChain", Type: "string"},
+		{Name: "agentAddress", Type: "address"},
+		{Name: "agentName", Type: "string"},
+		{Name: "nonce", Type: "uint64"},
+	}},
+	"approveBuilderFee": {"HyperliquidTransaction:ApproveBuilderFee", []apitypes.Type{
+		{Name: "hyperliquidChain", Type: "string"},
+		{Name: "maxFeeRate", Type: "string"},
+		{Name: "builder", Type: "address"},
+		{Name: "nonce", Type: "uint64"},
+	}},
+	"convertToMultiSigUser": {"HyperliquidTransaction:ConvertToMultiSigUser", []apitypes.Type{
+		{Name: "hyperliquidChain", Type: "string"},
+		{Name: "signers", Type: "string"},
+		{Name: "nonce", Type: "uint64"},
+	}},
+	"multiSig": {"HyperliquidTransaction:SendMultiSig", []apitypes.Type{
+		{Name: "hyperliquidChain", Type: "string"},
+		{Name: "multiSigActionHash", Type: "bytes32"},
+		{Name: "nonce", Type: "uint64"},
+	}},
+}
+
+// userSignedPayload builds the EIP-712 payload of a user signed action. The
+// domain chain id comes from the signatureChainId of the action.
+func userSignedPayload(
+	action map[string]any,
+	payloadTypes []apitypes.Type,
+	primaryType string,
+) (apitypes.TypedData, error) {
+	chainIDHex, _ := action["signatureChainId"].(string)
+	var chainID math.HexOrDecimal256
+	if err := chainID.UnmarshalText([]byte(chainIDHex)); err != nil {
+		return apitypes.TypedData{}, fmt.Errorf("invalid signatureChainId %q: %w", chainIDHex, err)
+	}
+
+	message := make(map[string]any, len(payloadTypes))
+	for _, field := range payloadTypes {
+		value, ok := action[field.Name]
+		if !ok && field.Name == "agentName" {
+			// Unnamed agents are signed with an empty name and sent without one
+			value, ok = "", true
+		}
+		if !ok {
+			return apitypes.TypedData{}, fmt.Errorf("action is missing field %s", field.Name)
+		}
+		message[field.Name] = typedValue(field.Type, value)
+	}
+
+	return apitypes.TypedData{
+		Domain: apitypes.TypedDataDomain{
+			ChainId:           &chainID,
+			Name:              "HyperliquidSignTransaction",
+			Version:           "1",
+			VerifyingContract: "0x0000000000000000000000000000000000000000",
+		},
+		Types: apitypes.Types{
+			primaryType: payloadTypes,
+			"EIP712Domain": []apitypes.Type{
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+		},
+		PrimaryType: primaryType,
+		Message:     message,
+	}, nil
+}
+
+// typedValue converts the Go integers apitypes does not take to *big.Int
+func typedValue(typ string, value any) any {
+	if !strings.HasPrefix(typ, "uint") && !strings.HasPrefix(typ, "int") {
+		return value
+	}
+	switch v := value.(type) {
+	case int:
+		return big.NewInt(int64(v))
+	case int64:
+		return big.NewInt(v)
+	case uint64:
+		return new(big.Int).SetUint64(v)
+	case json.Number:
+		if n, ok := new(big.Int).SetString(v.String(), 10); ok {
+			return n
+		}
+	}
+	return value
+}
+
+// SignUserSignedAction implements the same logic as Python's
+// sign_user_signed_action. It sets the hyperliquidChain and signatureChainId
+// fields of action before signing it.
+func SignUserSignedAction(
+	privateKey *ecdsa.PrivateKey,
+	action map[string]any,
+	payloadTypes []apitypes.Type,
+	primaryType string,
+	isMainnet bool,
+) (SignatureResult, error) {
+	action["signatureChainId"] = SignatureChainID
+	action["hyperliquidChain"] = hyperliquidChain(isMainnet)
+
+	typedData, err := userSignedPayload(action, payloadTypes, primaryType)
+	if err != nil {
+		return SignatureResult{}, err
+	}
+	return signInner(privateKey, typedData)
+}
+
+// hyperliquidChain returns the hyperliquidChain field of user signed actions
+func hyperliquidChain(isMainnet bool) string {
+	if isMainnet {
+		return "Mainnet"
+	}
+	return "Testnet"
+}
+
+// RecoverUserSignedSigner returns the address that produced signature for a
+// user signed action with the given EIP-712 payload type
+func RecoverUserSignedSigner(
+	action map[string]any,
+	payloadTypes []apitypes.Type,
+	primaryType string,
+	signature SignatureResult,
+) (string, error) {
+	typedData, err := userSignedPayload(action, payloadTypes, primaryType)
+	if err != nil {
+		return "", err
+	}
+	return recoverSigner(typedData, signature)
+}
+
+// IsUserSignedAction reports whether actions of the given type are signed
+// with an EIP-712 user signature rather than as L1 actions
+func IsUserSignedAction(actionType string) bool {
+	_, ok := userSignedTypes[actionType]
+	return ok
+}
+
+// RecoverUserSignedActionSigner is RecoverUserSignedSigner for the user signed
+// action types of the API, picked by the type field of action
+func RecoverUserSignedActionSigner(
+	action map[string]any,
+	signature SignatureResult,
+) (string, error) {
+	actionType, _ := action["type"].(string)
+	typ, ok := userSignedTypes[actionType]
+	if !ok {
+		return "", fmt.Errorf("unknown user signed action type %q", actionType)
+	}
+	return RecoverUserSignedSigner(action, typ.fields, typ.primaryType, signature)
+}
+
+// recoverSigner returns the address whose key signed typedData
+func recoverSigner(typedData apitypes.TypedData, signature SignatureResult) (string, error) {
+	digest, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash typed data: %w", err)
+	}
+
+	sig, err := signatureBytes(signature)
+	if err != nil {
+		return "", err
+	}
+
+	pub, err := crypto.SigToPub(digest, sig)
+	if err != nil {
+		return "", fmt.Errorf("failed to recover signer: %w", err)
+	}
+	return crypto.PubkeyToAddress(*pub).Hex(), nil
+}
+
+// signatureBytes converts a signature back to the 65 byte [R || S || V] form,
+// with V as the 0 or 1 recovery id
+func signatureBytes(signature SignatureResult) ([]byte, error) {
+	r, err := hexutil.DecodeBig(signature.R)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature r: %w", err)
+	}
+	s, err := hexutil.DecodeBig(signature.S)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature s: %w", err)
+	}
+	if r.BitLen() > 256 || s.BitLen() > 256 {
+		return nil, errors.New("invalid signature: r or s overflows 32 bytes")
+	}
+	if signature.V != 27 && signature.V != 28 {
+		return nil, fmt.Errorf("invalid signature v: %d", signature.V)
+	}
+
+	sig := make([]byte, 65)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:64])
+	sig[64] = byte(signature.V - 27)
+	return sig, nil
+}
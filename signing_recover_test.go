@@ -0,0 +1,222 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecoverL1Signer(t *testing.T) {
+	privateKey, err := crypto.HexToECDSA(
+		"0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+	)
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+
+	cloid := CloidFor(1, 2)
+	action := OrderAction{
+		Type: "order",
+		Orders: []OrderWire{{
+			Asset:      1,
+			IsBuy:      true,
+			LimitPx:    "3900.5",
+			Size:       "0.5",
+			ReduceOnly: false,
+			OrderType: OrderTypeWire{
+				Limit: &LimitOrderTypeWire{Tif: "Gtc"},
+			},
+			Cloid: &cloid,
+		}},
+		Grouping: "na",
+	}
+	vault := "0x1234567890123456789012345678901234567890"
+	expiresAfter := int64(1640995260000)
+	nonce := int64(1640995200000)
+
+	signature, err := SignL1Action(privateKey, action, vault, nonce, &expiresAfter, false)
+	require.NoError(t, err)
+
+	signer, err := RecoverL1Signer(action, vault, nonce, &expiresAfter, signature, false)
+	require.NoError(t, err)
+	assert.Equal(t, address, signer)
+
+	// The wire JSON hashes like the typed action
+	raw, err := json.Marshal(action)
+	require.NoError(t, err)
+	typedHash, err := ActionHash(action, vault, nonce, &expiresAfter)
+	require.NoError(t, err)
+	rawHash, err := ActionHash(RawAction(raw), vault, nonce, &expiresAfter)
+	require.NoError(t, err)
+	assert.Equal(t, typedHash, rawHash)
+
+	signer, err = RecoverL1Signer(RawAction(raw), vault, nonce, &expiresAfter, signature, false)
+	require.NoError(t, err)
+	assert.Equal(t, address, signer)
+
+	// Anything else that was signed recovers to another address
+	for name, recover := range map[string]func() (string, error){
+		"nonce": func() (string, error) {
+			return RecoverL1Signer(action, vault, nonce+1, &expiresAfter, signature, false)
+		},
+		"vault": func() (string, error) {
+			return RecoverL1Signer(action, "", nonce, &expiresAfter, signature, false)
+		},
+		"expiresAfter": func() (string, error) {
+			return RecoverL1Signer(action, vault, nonce, nil, signature, false)
+		},
+		"network": func() (string, error) {
+			return RecoverL1Signer(action, vault, nonce, &expiresAfter, signature, true)
+		},
+	} {
+		signer, err := recover()
+		require.NoError(t, err, name)
+		assert.NotEqual(t, address, signer, name)
+	}
+}
+
+func TestRawAction_Maps(t *testing.T) {
+	privateKey, err := crypto.HexToECDSA(
+		"0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+	)
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+	nonce := int64(1640995200000)
+
+	actions := map[string]any{
+		"updateLeverage": UpdateLeverageAction{
+			Type:     "updateLeverage",
+			Asset:    1,
+			Leverage: LeverageWire{Type: "isolated", Value: 5},
+		},
+		"map action": map[string]any{
+			"type":      "vaultTransfer",
+			"usd":       1000000,
+			"isDeposit": true,
+			"nested":    map[string]any{"z": 1, "a": "2", "m": []any{map[string]any{"y": 1, "b": 2}}},
+		},
+	}
+	for name, action := range actions {
+		signature, err := SignL1Action(privateKey, action, "", nonce, nil, false)
+		require.NoError(t, err, name)
+
+		// Map keys would be sent in random order if they were not sorted
+		for range 20 {
+			payload, err := json.Marshal(newExchangePayload(action, signature, nonce, "", nil))
+			require.NoError(t, err, name)
+			var sent struct {
+				Action RawAction `json:"action"`
+			}
+			require.NoError(t, json.Unmarshal(payload, &sent), name)
+
+			signer, err := RecoverL1Signer(sent.Action, "", nonce, nil, signature, false)
+			require.NoError(t, err, name)
+			require.Equal(t, address, signer, name)
+		}
+	}
+}
+
+func TestRecoverL1Signer_InvalidSignature(t *testing.T) {
+	action := map[string]any{"type": "noop"}
+
+	tests := []struct {
+		name      string
+		signature SignatureResult
+		wantErr   string
+	}{
+		{
+			name:      "bad v",
+			signature: SignatureResult{R: "0x1", S: "0x1", V: 29},
+			wantErr:   "invalid signature v",
+		},
+		{
+			name:      "bad r",
+			signature: SignatureResult{R: "zz", S: "0x1", V: 27},
+			wantErr:   "invalid signature r",
+		},
+		{
+			name:      "zero r",
+			signature: SignatureResult{R: "0x0", S: "0x1", V: 27},
+			wantErr:   "failed to recover signer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := RecoverL1Signer(action, "", 1, nil, tt.signature, false)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestRecoverUserSignedActionSigner(t *testing.T) {
+	privateKey, err := crypto.HexToECDSA(
+		"0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+	)
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+
+	tests := []struct {
+		name   string
+		action map[string]any
+	}{
+		{
+			name: "usdSend",
+			action: map[string]any{
+				"type":        "usdSend",
+				"destination": "0x5e9ee1089755c3435139848e47e6635505d5a13a",
+				"amount":      "1.5",
+				"time":        int64(1640995200000),
+			},
+		},
+		{
+			name: "approveAgent",
+			action: map[string]any{
+				"type":         "approveAgent",
+				"agentAddress": "0x5e9ee1089755c3435139848e47e6635505d5a13a",
+				"agentName":    "bot",
+				"nonce":        int64(1640995200000),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			typ := userSignedTypes[tt.name]
+			signature, err := SignUserSignedAction(
+				privateKey,
+				tt.action,
+				typ.fields,
+				typ.primaryType,
+				true,
+			)
+			require.NoError(t, err)
+			assert.Equal(t, "Mainnet", tt.action["hyperliquidChain"])
+			assert.Equal(t, SignatureChainID, tt.action["signatureChainId"])
+
+			signer, err := RecoverUserSignedActionSigner(tt.action, signature)
+			require.NoError(t, err)
+			assert.Equal(t, address, signer)
+
+			// As received from the wire, numbers decode as float64
+			raw, err := json.Marshal(tt.action)
+			require.NoError(t, err)
+			var decoded map[string]any
+			require.NoError(t, json.Unmarshal(raw, &decoded))
+			signer, err = RecoverUserSignedActionSigner(decoded, signature)
+			require.NoError(t, err)
+			assert.Equal(t, address, signer)
+
+			tt.action["hyperliquidChain"] = "Testnet"
+			signer, err = RecoverUserSignedActionSigner(tt.action, signature)
+			require.NoError(t, err)
+			assert.NotEqual(t, address, signer)
+		})
+	}
+
+	_, err = RecoverUserSignedActionSigner(map[string]any{"type": "order"}, SignatureResult{})
+	assert.ErrorContains(t, err, "unknown user signed action type")
+}
@@ -2,6 +2,7 @@ package hyperliquid
 
 import (
 	"encoding/hex"
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/crypto"
@@ -277,10 +278,8 @@ func TestDebugActionHash(t *testing.T) {
 			LimitPx:    "100.5",
 			Size:       "1.0",
 			ReduceOnly: false,
-			OrderType: map[string]any{
-				"limit": map[string]any{
-					"tif": "Gtc",
-				},
+			OrderType: OrderTypeWire{
+				Limit: &LimitOrderTypeWire{Tif: "Gtc"},
 			},
 		}},
 		Grouping: "na",
@@ -295,7 +294,8 @@ func TestDebugActionHash(t *testing.T) {
 	isMainnet := false
 
 	// Debug: Print action hash components
-	hash := actionHash(action, vaultAddress, timestamp, expiresAfter)
+	hash, err := ActionHash(action, vaultAddress, timestamp, expiresAfter)
+	require.NoError(t, err)
 	t.Logf("Action hash: %x", hash)
 
 	// Debug: Print phantom agent
@@ -314,3 +314,193 @@ func TestDebugActionHash(t *testing.T) {
 	require.NoError(t, err)
 	t.Logf("Generated signature: R=%s, S=%s, V=%d", signature.R, signature.S, signature.V)
 }
+
+// TestSignL1Action_OrderGolden checks order actions against the signatures of
+// the reference Python SDK (tests/signing_test.py).
+func TestSignL1Action_OrderGolden(t *testing.T) {
+	privateKey, err := crypto.HexToECDSA(
+		"0123456789012345678901234567890123456789012345678901234567890123",
+	)
+	require.NoError(t, err)
+
+	exchange := newMemoryExchange(t, &memoryTransport{})
+	cloid := MustParseCloid("0x00000000000000000000000000000001")
+	limit := OrderType{Limit: &LimitOrderType{Tif: TifGtc}}
+
+	tests := []struct {
+		name        string
+		orderType   OrderType
+		cloid       *Cloid
+		builder     *BuilderInfo
+		wantMsgpack string
+		mainnet     SignatureResult
+		testnet     SignatureResult
+	}{
+		{
+			name:      "limit",
+			orderType: limit,
+			mainnet: SignatureResult{
+				R: "0xd65369825a9df5d80099e513cce430311d7d26ddf477f5b3a33d2806b100d78e",
+				S: "0x2b54116ff64054968aa237c20ca9ff68000f977c93289157748a3162b6ea940e",
+				V: 28,
+			},
+			testnet: SignatureResult{
+				R: "0x82b2ba28e76b3d761093aaded1b1cdad4960b3af30212b343fb2e6cdfa4e3d54",
+				S: "0x6b53878fc99d26047f4d7e8c90eb98955a109f44209163f52d8dc4278cbbd9f5",
+				V: 27,
+			},
+		},
+		{
+			name:      "cloid",
+			orderType: limit,
+			cloid:     &cloid,
+			mainnet: SignatureResult{
+				R: "0x41ae18e8239a56cacbc5dad94d45d0b747e5da11ad564077fcac71277a946e3",
+				S: "0x3c61f667e747404fe7eea8f90ab0e76cc12ce60270438b2058324681a00116da",
+				V: 27,
+			},
+			testnet: SignatureResult{
+				R: "0xeba0664bed2676fc4e5a743bf89e5c7501aa6d870bdb9446e122c9466c5cd16d",
+				S: "0x7f3e74825c9114bc59086f1eebea2928c190fdfbfde144827cb02b85bbe90988",
+				V: 28,
+			},
+		},
+		{
+			name: "trigger",
+			orderType: OrderType{
+				Trigger: &TriggerOrderType{TriggerPx: 103, IsMarket: true, Tpsl: "sl"},
+			},
+			mainnet: SignatureResult{
+				R: "0x98343f2b5ae8e26bb2587daad3863bc70d8792b09af1841b6fdd530a2065a3f9",
+				S: "0x6b5bb6bb0633b710aa22b721dd9dee6d083646a5f8e581a20b545be6c1feb405",
+				V: 27,
+			},
+			testnet: SignatureResult{
+				R: "0x971c554d917c44e0e1b6cc45d8f9404f32172a9d3b3566262347d0302896a2e4",
+				S: "0x206257b104788f80450f8e786c329daa589aa0b32ba96948201ae556d5637eac",
+				V: 28,
+			},
+		},
+		{
+			name:      "builder",
+			orderType: limit,
+			builder:   &BuilderInfo{Builder: "0x8C967E73E7B15087c42A10D344cFf4c96D877F1D", Fee: 10},
+			// msgpack.packb of the Python SDK action
+			wantMsgpack: "84a474797065a56f72646572a66f72646572739186a16101a162c3a170a3313030a173a3313030" +
+				"a172c2a17481a56c696d697481a3746966a3477463a867726f7570696e67a26e61a76275696c64657282" +
+				"a162d92a307838633936376537336537623135303837633432613130643334346366663463393664383737" +
+				"663164a1660a",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			action, err := newCreateOrderAction(exchange, []CreateOrderRequest{{
+				Coin:          "ETH",
+				IsBuy:         true,
+				Price:         100,
+				Size:          100,
+				OrderType:     tc.orderType,
+				ClientOrderID: tc.cloid,
+			}}, GroupingNA, tc.builder)
+			require.NoError(t, err)
+
+			if tc.wantMsgpack != "" {
+				packed, err := packAction(action)
+				require.NoError(t, err)
+				assert.Equal(t, tc.wantMsgpack, hex.EncodeToString(packed))
+			}
+
+			for _, isMainnet := range []bool{true, false} {
+				want := tc.testnet
+				if isMainnet {
+					want = tc.mainnet
+				}
+				if want.R == "" {
+					continue
+				}
+				got, err := SignL1Action(privateKey, action, "", 0, nil, isMainnet)
+				require.NoError(t, err)
+				assert.Equal(t, want, got, "mainnet=%v", isMainnet)
+			}
+		})
+	}
+}
+
+// TestSignUserSignedAction_Golden checks a usdSend against the signature of
+// the reference Python SDK (tests/signing_test.py)
+func TestSignUserSignedAction_Golden(t *testing.T) {
+	privateKey, err := crypto.HexToECDSA(
+		"0123456789012345678901234567890123456789012345678901234567890123",
+	)
+	require.NoError(t, err)
+
+	typ := userSignedTypes["usdSend"]
+	sig, err := SignUserSignedAction(privateKey, map[string]any{
+		"destination": "0x5e9ee1089755c3435139848e47e6635505d5a13a",
+		"amount":      "1",
+		"time":        int64(1687816341423),
+	}, typ.fields, typ.primaryType, false)
+	require.NoError(t, err)
+	assert.Equal(t, SignatureResult{
+		R: "0x637b37dd731507cdd24f46532ca8ba6eec616952c56218baeff04144e4a77073",
+		S: "0x11a6a24900e6e314136d2592e2f8d502cd89b7c15b198e1bee043c9589f9fad7",
+		V: 27,
+	}, sig)
+}
+
+func TestSignMultiSigUserSignedActionPayload(t *testing.T) {
+	privateKey, err := crypto.HexToECDSA(
+		"0123456789012345678901234567890123456789012345678901234567890123",
+	)
+	require.NoError(t, err)
+	signer := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+
+	action := map[string]any{
+		"type":        "usdSend",
+		"destination": "0x5e9ee1089755c3435139848e47e6635505d5a13a",
+		"amount":      "1",
+		"time":        int64(1687816341423),
+	}
+	multiSigUser := "0x0000000000000000000000000000000000000005"
+	outerSigner := "0x0D1d9635D0640821d15e323ac8AdADfA9c111414"
+
+	typ := userSignedTypes["usdSend"]
+	sig, err := SignMultiSigUserSignedActionPayload(
+		privateKey,
+		action,
+		typ.fields,
+		typ.primaryType,
+		multiSigUser,
+		outerSigner,
+		false,
+	)
+	require.NoError(t, err)
+
+	// The multi-sig fields follow hyperliquidChain, like Python's
+	// add_multi_sig_types
+	envelope, envelopeTypes := multiSigUserSignedEnvelope(action, typ.fields, multiSigUser, outerSigner)
+	envelope["signatureChainId"] = SignatureChainID
+	envelope["hyperliquidChain"] = "Testnet"
+	typedData, err := userSignedPayload(envelope, envelopeTypes, typ.primaryType)
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		"HyperliquidTransaction:UsdSend(string hyperliquidChain,address payloadMultiSigUser,"+
+			"address outerSigner,string destination,string amount,uint64 time)",
+		string(typedData.EncodeType(typ.primaryType)),
+	)
+	assert.Equal(t, strings.ToLower(outerSigner), envelope["outerSigner"])
+	assert.NotContains(t, action, "outerSigner")
+
+	recovered, err := RecoverUserSignedSigner(envelope, envelopeTypes, typ.primaryType, sig)
+	require.NoError(t, err)
+	assert.Equal(t, signer, recovered)
+
+	// It does not pass for a plain signature of the action
+	action["signatureChainId"] = SignatureChainID
+	action["hyperliquidChain"] = "Testnet"
+	recovered, err = RecoverUserSignedActionSigner(action, sig)
+	require.NoError(t, err)
+	assert.NotEqual(t, signer, recovered)
+}
@@ -0,0 +1,95 @@
+package hyperliquid
+
+import (
+	"context"
+	"errors"
+)
+
+// Transport submits info queries and signed action payloads to Hyperliquid.
+// Both methods return the raw response body, so callers parse responses the
+// same way regardless of how they were delivered.
+type Transport interface {
+	// Info sends an info request, e.g. {"type":"meta"}
+	Info(ctx context.Context, request any) ([]byte, error)
+	// Exchange sends a signed action payload as built by newExchangePayload
+	Exchange(ctx context.Context, payload any) ([]byte, error)
+}
+
+// HTTPTransport sends requests to the REST /info and /exchange endpoints.
+type HTTPTransport struct {
+	client *Client
+}
+
+func NewHTTPTransport(baseURL string) *HTTPTransport {
+	return &HTTPTransport{client: NewClient(baseURL)}
+}
+
+func (t *HTTPTransport) Info(ctx context.Context, request any) ([]byte, error) {
+	return t.client.postContext(ctx, "/info", request)
+}
+
+func (t *HTTPTransport) Exchange(ctx context.Context, payload any) ([]byte, error) {
+	return t.client.postContext(ctx, "/exchange", payload)
+}
+
+// WebsocketTransport sends requests as websocket post requests.
+// The websocket client must be connected before it is used.
+type WebsocketTransport struct {
+	ws *WebsocketClient
+}
+
+func NewWebsocketTransport(ws *WebsocketClient) *WebsocketTransport {
+	return &WebsocketTransport{ws: ws}
+}
+
+func (t *WebsocketTransport) Info(ctx context.Context, request any) ([]byte, error) {
+	return t.ws.PostInfo(ctx, request)
+}
+
+func (t *WebsocketTransport) Exchange(ctx context.Context, payload any) ([]byte, error) {
+	return t.ws.PostAction(ctx, payload)
+}
+
+// FallbackTransport tries the primary transport first and retries on the
+// fallback one when the primary could not deliver the request. Errors
+// returned by the server itself are not retried.
+//
+// Info requests are retried after any delivery failure. Signed actions are
+// only resent when the primary provably never sent them: after a timeout or
+// a dropped connection the action may already have executed, and resending
+// it would fail with a duplicate nonce and hide that it went through.
+type FallbackTransport struct {
+	primary  Transport
+	fallback Transport
+}
+
+func NewFallbackTransport(primary, fallback Transport) *FallbackTransport {
+	return &FallbackTransport{primary: primary, fallback: fallback}
+}
+
+func (t *FallbackTransport) Info(ctx context.Context, request any) ([]byte, error) {
+	resp, err := t.primary.Info(ctx, request)
+	if !t.shouldFallback(ctx, err) {
+		return resp, err
+	}
+	return t.fallback.Info(ctx, request)
+}
+
+func (t *FallbackTransport) Exchange(ctx context.Context, payload any) ([]byte, error) {
+	resp, err := t.primary.Exchange(ctx, payload)
+	var notSent notSentError
+	if ctx.Err() != nil || !errors.As(err, &notSent) {
+		return resp, err
+	}
+	return t.fallback.Exchange(ctx, payload)
+}
+
+func (t *FallbackTransport) shouldFallback(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+
+	var postErr PostError
+	var apiErr APIError
+	return !errors.As(err, &postErr) && !errors.As(err, &apiErr)
+}
@@ -0,0 +1,223 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryTransport is an in-memory Transport that records every request and
+// answers with canned responses.
+type memoryTransport struct {
+	infoRequests     []json.RawMessage
+	exchangePayloads []json.RawMessage
+	infoResponse     func(request map[string]any) ([]byte, error)
+	exchangeResponse func(payload map[string]any) ([]byte, error)
+}
+
+func (m *memoryTransport) Info(_ context.Context, request any) ([]byte, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	m.infoRequests = append(m.infoRequests, data)
+
+	var req map[string]any
+	if err := json.Unmarshal(data, &req); err != nil {
+		return nil, err
+	}
+	return m.infoResponse(req)
+}
+
+func (m *memoryTransport) Exchange(_ context.Context, payload any) ([]byte, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	m.exchangePayloads = append(m.exchangePayloads, data)
+
+	var req map[string]any
+	if err := json.Unmarshal(data, &req); err != nil {
+		return nil, err
+	}
+	return m.exchangeResponse(req)
+}
+
+func newMemoryExchange(t *testing.T, transport Transport) *Exchange {
+	t.Helper()
+
+	privateKey, err := crypto.HexToECDSA(
+		"38d55ff1195c57b9dbc8a72c93119500f1fcd47a33f98149faa18d2fc37932fa",
+	)
+	require.NoError(t, err)
+
+	meta := &Meta{Universe: []AssetInfo{
+		{Name: "BTC", SzDecimals: 5},
+		{Name: "ETH", SzDecimals: 4},
+	}}
+
+	exchange := NewExchange(
+		privateKey,
+		TestnetAPIURL,
+		meta,
+		"",
+		crypto.PubkeyToAddress(privateKey.PublicKey).Hex(),
+		&SpotMeta{},
+	)
+	exchange.SetTransport(transport)
+	return exchange
+}
+
+func TestExchange_MemoryTransport(t *testing.T) {
+	transport := &memoryTransport{
+		exchangeResponse: func(map[string]any) ([]byte, error) {
+			return []byte(`{"status":"ok","response":{"type":"order","data":{"statuses":[{"resting":{"oid":77738308}}]}}}`), nil
+		},
+	}
+	exchange := newMemoryExchange(t, transport)
+
+	status, err := exchange.Order(CreateOrderRequest{
+		Coin:      "ETH",
+		IsBuy:     true,
+		Price:     1000,
+		Size:      0.01,
+		OrderType: OrderType{Limit: &LimitOrderType{Tif: TifGtc}},
+	}, nil)
+	require.NoError(t, err)
+	require.NotNil(t, status.Resting)
+	assert.Equal(t, int64(77738308), status.Resting.Oid)
+
+	require.Len(t, transport.exchangePayloads, 1)
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal(transport.exchangePayloads[0], &payload))
+	assert.Contains(t, payload, "nonce")
+	assert.Contains(t, payload, "signature")
+	assert.JSONEq(
+		t,
+		`{"type":"order","orders":[{"a":1,"b":true,"p":"1000","s":"0.01","r":false,"t":{"limit":{"tif":"Gtc"}}}],"grouping":"na"}`,
+		string(mustMarshal(t, payload["action"])),
+	)
+}
+
+func TestInfo_MemoryTransport(t *testing.T) {
+	transport := &memoryTransport{
+		infoResponse: func(req map[string]any) ([]byte, error) {
+			assert.Equal(t, "allMids", req["type"])
+			return []byte(`{"BTC":"100000.5","ETH":"4300.1"}`), nil
+		},
+	}
+	info := NewInfo(TestnetAPIURL, true, &Meta{}, &SpotMeta{})
+	info.SetTransport(transport)
+
+	mids, err := info.AllMids()
+	require.NoError(t, err)
+	assert.Equal(t, "4300.1", mids["ETH"])
+	require.Len(t, transport.infoRequests, 1)
+}
+
+func TestFallbackTransport(t *testing.T) {
+	ok := func(map[string]any) ([]byte, error) { return []byte(`"fallback"`), nil }
+
+	tests := []struct {
+		name         string
+		primaryErr   error
+		wantFallback bool
+		wantErr      bool
+	}{
+		{
+			name:         "primary succeeds",
+			primaryErr:   nil,
+			wantFallback: false,
+		},
+		{
+			name:         "unsent request falls back",
+			primaryErr:   notSentError{err: errConnectionClosed},
+			wantFallback: true,
+		},
+		{
+			name:         "connection closed after send does not fall back",
+			primaryErr:   errConnectionClosed,
+			wantFallback: false,
+			wantErr:      true,
+		},
+		{
+			name:         "timeout does not fall back",
+			primaryErr:   context.DeadlineExceeded,
+			wantFallback: false,
+			wantErr:      true,
+		},
+		{
+			name:         "server post error does not fall back",
+			primaryErr:   PostError{ID: 1, Message: "Invalid request"},
+			wantFallback: false,
+			wantErr:      true,
+		},
+		{
+			name:         "api error does not fall back",
+			primaryErr:   APIError{Code: 422, Message: "bad"},
+			wantFallback: false,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary := &memoryTransport{
+				exchangeResponse: func(map[string]any) ([]byte, error) {
+					if tt.primaryErr != nil {
+						return nil, tt.primaryErr
+					}
+					return []byte(`"primary"`), nil
+				},
+			}
+			fallback := &memoryTransport{exchangeResponse: ok}
+
+			resp, err := NewFallbackTransport(primary, fallback).
+				Exchange(context.Background(), map[string]any{"nonce": 1})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, tt.primaryErr))
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tt.wantFallback {
+				assert.Equal(t, `"fallback"`, string(resp))
+				assert.Equal(t, primary.exchangePayloads, fallback.exchangePayloads)
+			} else {
+				assert.Empty(t, fallback.exchangePayloads)
+			}
+		})
+	}
+}
+
+func TestFallbackTransport_Info(t *testing.T) {
+	primary := &memoryTransport{
+		infoResponse: func(map[string]any) ([]byte, error) {
+			return nil, context.DeadlineExceeded
+		},
+	}
+	fallback := &memoryTransport{
+		infoResponse: func(map[string]any) ([]byte, error) { return []byte(`"fallback"`), nil },
+	}
+
+	// Queries are read-only, so they are retried after a timeout
+	resp, err := NewFallbackTransport(primary, fallback).
+		Info(context.Background(), map[string]any{"type": "allMids"})
+	require.NoError(t, err)
+	assert.Equal(t, `"fallback"`, string(resp))
+	assert.Equal(t, primary.infoRequests, fallback.infoRequests)
+}
+
+func mustMarshal(t *testing.T, v any) []byte {
+	t.Helper()
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return data
+}
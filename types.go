@@ -1,5 +1,10 @@
 package hyperliquid
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 //go:generate easyjson -all
 
 type Side string
@@ -14,7 +19,10 @@ type Grouping string
 const (
 	GroupingNA           Grouping = "na"
 	GroupingNormalTpsl   Grouping = "normalTpsl"
-	GroupingPositionTpls Grouping = "positionTpsl"
+	GroupingPositionTpsl Grouping = "positionTpsl"
+
+	// Deprecated: use GroupingPositionTpsl.
+	GroupingPositionTpls = GroupingPositionTpsl
 )
 
 // Constants for default values
@@ -32,8 +40,12 @@ const (
 )
 
 type AssetInfo struct {
-	Name       string `json:"name"`
-	SzDecimals int    `json:"szDecimals"`
+	Name          string `json:"name"`
+	SzDecimals    int    `json:"szDecimals"`
+	MaxLeverage   int    `json:"maxLeverage,omitempty"`
+	MarginTableID int    `json:"marginTableId,omitempty"`
+	OnlyIsolated  bool   `json:"onlyIsolated,omitempty"`
+	IsDelisted    bool   `json:"isDelisted,omitempty"`
 }
 
 type MarginTier struct {
@@ -136,8 +148,8 @@ type TriggerOrderType struct {
 }
 
 type BuilderInfo struct {
-	Builder string `json:"b"`
-	Fee     int    `json:"f"`
+	Builder string `json:"b" msgpack:"b"`
+	Fee     int    `json:"f" msgpack:"f"`
 }
 
 type CancelRequest struct {
@@ -147,15 +159,7 @@ type CancelRequest struct {
 
 type CancelByCloidRequest struct {
 	Coin  string `json:"coin"`
-	Cloid string `json:"cloid"`
-}
-
-type Cloid struct {
-	Value string
-}
-
-func (c Cloid) ToRaw() string {
-	return c.Value
+	Cloid Cloid  `json:"cloid"`
 }
 
 type PerpDexSchemaInput struct {
@@ -245,6 +249,8 @@ type QueriedOrder struct {
 	OrigSz           string    `json:"origSz"`
 	Tif              Tif       `json:"tif"`
 	Cloid            *string   `json:"cloid"`
+	// Children holds the TP/SL orders attached to this order, if any
+	Children []QueriedOrder `json:"children,omitempty"`
 }
 
 type OrderQueryResponse struct {
@@ -316,6 +322,48 @@ const (
 	OrderStatusValuePerpMaxPositionRejected OrderStatusValue = "perpMaxPositionRejected"
 )
 
+// UserRateLimit reports the request budget of an address. The cap grows with
+// traded volume.
+type UserRateLimit struct {
+	CumVlm           string `json:"cumVlm"`
+	NRequestsUsed    int64  `json:"nRequestsUsed"`
+	NRequestsCap     int64  `json:"nRequestsCap"`
+	NRequestsSurplus int64  `json:"nRequestsSurplus,omitempty"`
+}
+
+// Remaining returns how many requests are left before the address is rate limited
+func (r UserRateLimit) Remaining() int64 {
+	return r.NRequestsCap + r.NRequestsSurplus - r.NRequestsUsed
+}
+
+type UserRoleType string
+
+const (
+	UserRoleMissing    UserRoleType = "missing"
+	UserRoleUser       UserRoleType = "user"
+	UserRoleAgent      UserRoleType = "agent"
+	UserRoleVault      UserRoleType = "vault"
+	UserRoleSubAccount UserRoleType = "subAccount"
+)
+
+type UserRole struct {
+	Role UserRoleType  `json:"role"`
+	Data *UserRoleData `json:"data,omitempty"`
+}
+
+// UserRoleData links an agent to its user or a sub-account to its master
+type UserRoleData struct {
+	User   string `json:"user,omitempty"`
+	Master string `json:"master,omitempty"`
+}
+
+// ExtraAgent is a named API wallet approved by a user
+type ExtraAgent struct {
+	Address    string `json:"address"`
+	Name       string `json:"name"`
+	ValidUntil int64  `json:"validUntil"`
+}
+
 type OrderQueryStatus string
 
 const (
@@ -344,6 +392,26 @@ type Fill struct {
 	FeeToken      string `json:"feeToken"`
 	BuilderFee    string `json:"builderFee,omitempty"`
 	Tid           int64  `json:"tid"`
+	// Cloid is the client order id of the order, if it had one
+	Cloid *string `json:"cloid,omitempty"`
+	// Liquidation is set when the fill is part of a liquidation
+	Liquidation *FillLiquidation `json:"liquidation,omitempty"`
+	// TwapID is set when the fill comes from a TWAP slice
+	TwapID *int64 `json:"twapId,omitempty"`
+}
+
+type FillLiquidationMethod string
+
+const (
+	FillLiquidationMethodMarket   FillLiquidationMethod = "market"
+	FillLiquidationMethodBackstop FillLiquidationMethod = "backstop"
+)
+
+type FillLiquidation struct {
+	// LiquidatedUser is empty when the fill belongs to the liquidated user
+	LiquidatedUser string                `json:"liquidatedUser,omitempty"`
+	MarkPx         string                `json:"markPx"`
+	Method         FillLiquidationMethod `json:"method"`
 }
 
 type FundingHistory struct {
@@ -353,11 +421,20 @@ type FundingHistory struct {
 	Time        int64  `json:"time"`
 }
 
+// UserFundingHistory is a funding payment of a user for one coin
 type UserFundingHistory struct {
-	User      string `json:"user"`
-	Type      string `json:"type"`
-	StartTime int64  `json:"startTime"`
-	EndTime   int64  `json:"endTime"`
+	Time  int64            `json:"time"`
+	Hash  string           `json:"hash"`
+	Delta UserFundingDelta `json:"delta"`
+}
+
+type UserFundingDelta struct {
+	Type        string `json:"type"`
+	Coin        string `json:"coin"`
+	Usdc        string `json:"usdc"`
+	Szi         string `json:"szi"`
+	FundingRate string `json:"fundingRate"`
+	NSamples    *int   `json:"nSamples"`
 }
 
 type UserFees struct {
@@ -440,9 +517,11 @@ type SubAccount struct {
 	Permissions []string `json:"permissions"`
 }
 
-type MultiSigSigner struct {
-	User      string `json:"user"`
-	Threshold int    `json:"threshold"`
+// MultiSigSigners are the users authorized to sign for a multi-sig user and
+// how many of them must sign an action
+type MultiSigSigners struct {
+	AuthorizedUsers []string `json:"authorizedUsers"`
+	Threshold       int      `json:"threshold"`
 }
 
 type BulkOrderResponse struct {
@@ -487,6 +566,117 @@ type CreateVaultResponse struct {
 	Error  string `json:"error,omitempty"`
 }
 
+// PerpDex is a builder-deployed (HIP-3) perpetuals dex. Its coins are named
+// "<name>:<COIN>".
+//
+//easyjson:skip
+type PerpDex struct {
+	Name                  string                   `json:"name"`
+	FullName              string                   `json:"fullName"`
+	Deployer              string                   `json:"deployer"`
+	OracleUpdater         *string                  `json:"oracleUpdater"`
+	FeeRecipient          *string                  `json:"feeRecipient"`
+	AssetToStreamingOiCap []Tuple2[string, string] `json:"assetToStreamingOiCap,omitempty"`
+}
+
+// PortfolioPeriod names a window of the portfolio history. The perp prefixed
+// periods only account for perpetuals.
+type PortfolioPeriod string
+
+const (
+	PortfolioPeriodDay         PortfolioPeriod = "day"
+	PortfolioPeriodWeek        PortfolioPeriod = "week"
+	PortfolioPeriodMonth       PortfolioPeriod = "month"
+	PortfolioPeriodAllTime     PortfolioPeriod = "allTime"
+	PortfolioPeriodPerpDay     PortfolioPeriod = "perpDay"
+	PortfolioPeriodPerpWeek    PortfolioPeriod = "perpWeek"
+	PortfolioPeriodPerpMonth   PortfolioPeriod = "perpMonth"
+	PortfolioPeriodPerpAllTime PortfolioPeriod = "perpAllTime"
+)
+
+// PortfolioHistory holds [timestamp, value] samples for one period
+//
+//easyjson:skip
+type PortfolioHistory struct {
+	AccountValueHistory []Tuple2[int64, string] `json:"accountValueHistory"`
+	PnlHistory          []Tuple2[int64, string] `json:"pnlHistory"`
+	Vlm                 string                  `json:"vlm"`
+}
+
+// Portfolio is the account value and PnL history per period, as returned for
+// users and vaults.
+type Portfolio []Tuple2[PortfolioPeriod, PortfolioHistory]
+
+// Period returns the history for the given period
+func (p Portfolio) Period(period PortfolioPeriod) (PortfolioHistory, bool) {
+	for _, entry := range p {
+		if entry.First == period {
+			return entry.Second, true
+		}
+	}
+	return PortfolioHistory{}, false
+}
+
+//easyjson:skip
+type VaultDetails struct {
+	Name         string    `json:"name"`
+	VaultAddress string    `json:"vaultAddress"`
+	Leader       string    `json:"leader"`
+	Description  string    `json:"description"`
+	Portfolio    Portfolio `json:"portfolio"`
+	Apr          float64   `json:"apr"`
+	// FollowerState is set when the query names a user following the vault
+	FollowerState         *VaultFollower     `json:"followerState"`
+	LeaderFraction        float64            `json:"leaderFraction"`
+	LeaderCommission      float64            `json:"leaderCommission"`
+	Followers             []VaultFollower    `json:"followers"`
+	MaxDistributable      float64            `json:"maxDistributable"`
+	MaxWithdrawable       float64            `json:"maxWithdrawable"`
+	IsClosed              bool               `json:"isClosed"`
+	Relationship          *VaultRelationship `json:"relationship,omitempty"`
+	AllowDeposits         bool               `json:"allowDeposits"`
+	AlwaysCloseOnWithdraw bool               `json:"alwaysCloseOnWithdraw"`
+}
+
+type VaultFollower struct {
+	User           string `json:"user"`
+	VaultEquity    string `json:"vaultEquity"`
+	Pnl            string `json:"pnl"`
+	AllTimePnl     string `json:"allTimePnl"`
+	DaysFollowing  int    `json:"daysFollowing"`
+	VaultEntryTime int64  `json:"vaultEntryTime"`
+	LockupUntil    int64  `json:"lockupUntil"`
+}
+
+type VaultRelationshipType string
+
+const (
+	VaultRelationshipNormal VaultRelationshipType = "normal"
+	VaultRelationshipParent VaultRelationshipType = "parent"
+	VaultRelationshipChild  VaultRelationshipType = "child"
+)
+
+type VaultRelationship struct {
+	Type VaultRelationshipType  `json:"type"`
+	Data *VaultRelationshipData `json:"data,omitempty"`
+}
+
+type VaultRelationshipData struct {
+	ChildAddresses []string `json:"childAddresses,omitempty"`
+}
+
+type UserVaultEquity struct {
+	VaultAddress         string `json:"vaultAddress"`
+	Equity               string `json:"equity"`
+	LockedUntilTimestamp int64  `json:"lockedUntilTimestamp,omitempty"`
+}
+
+// LeadingVault is a vault led by the user
+type LeadingVault struct {
+	Address string `json:"address"`
+	Name    string `json:"name"`
+}
+
 type CreateSubAccountResponse struct {
 	Status string      `json:"status"`
 	Data   *SubAccount `json:"data,omitempty"`
@@ -507,6 +697,8 @@ type AgentApprovalResponse struct {
 	Status string `json:"status"`
 	TxHash string `json:"txHash,omitempty"`
 	Error  string `json:"error,omitempty"`
+	// Response holds the error message when Status is "err"
+	Response json.RawMessage `json:"response,omitempty"`
 }
 
 type MultiSigConversionResponse struct {
@@ -531,6 +723,8 @@ type MultiSigResponse struct {
 	Status string `json:"status"`
 	TxHash string `json:"txHash,omitempty"`
 	Error  string `json:"error,omitempty"`
+	// Response is the response of the inner action
+	Response json.RawMessage `json:"response,omitempty"`
 }
 
 type PerpDeployResponse struct {
@@ -544,3 +738,342 @@ type TxStatus struct {
 	Coin   string `json:"coin"`
 	Status string `json:"status"`
 }
+
+type LedgerDeltaType string
+
+const (
+	LedgerDeltaTypeDeposit               LedgerDeltaType = "deposit"
+	LedgerDeltaTypeWithdraw              LedgerDeltaType = "withdraw"
+	LedgerDeltaTypeInternalTransfer      LedgerDeltaType = "internalTransfer"
+	LedgerDeltaTypeSubAccountTransfer    LedgerDeltaType = "subAccountTransfer"
+	LedgerDeltaTypeLiquidation           LedgerDeltaType = "liquidation"
+	LedgerDeltaTypeVaultCreate           LedgerDeltaType = "vaultCreate"
+	LedgerDeltaTypeVaultDeposit          LedgerDeltaType = "vaultDeposit"
+	LedgerDeltaTypeVaultDistribution     LedgerDeltaType = "vaultDistribution"
+	LedgerDeltaTypeVaultWithdraw         LedgerDeltaType = "vaultWithdraw"
+	LedgerDeltaTypeVaultLeaderCommission LedgerDeltaType = "vaultLeaderCommission"
+	LedgerDeltaTypeSpotTransfer          LedgerDeltaType = "spotTransfer"
+	LedgerDeltaTypeAccountClassTransfer  LedgerDeltaType = "accountClassTransfer"
+	LedgerDeltaTypeSpotGenesis           LedgerDeltaType = "spotGenesis"
+	LedgerDeltaTypeRewardsClaim          LedgerDeltaType = "rewardsClaim"
+	LedgerDeltaTypeSend                  LedgerDeltaType = "send"
+	LedgerDeltaTypeCStakingTransfer      LedgerDeltaType = "cStakingTransfer"
+)
+
+// LedgerUpdate is a single non-funding ledger entry. It is shared by the
+// userNonFundingLedgerUpdates info request and websocket channel.
+type LedgerUpdate struct {
+	Time  int64       `json:"time"`
+	Hash  string      `json:"hash"`
+	Delta LedgerDelta `json:"delta"`
+}
+
+// LedgerDelta is a discriminated union over the ledger delta types. Only the
+// variant matching Type is set. Unknown types keep their payload in Raw.
+//
+//easyjson:skip
+type LedgerDelta struct {
+	Type                  LedgerDeltaType
+	Deposit               *LedgerDeposit
+	Withdraw              *LedgerWithdraw
+	InternalTransfer      *LedgerInternalTransfer
+	SubAccountTransfer    *LedgerSubAccountTransfer
+	Liquidation           *LedgerLiquidation
+	Vault                 *LedgerVaultDelta // vaultCreate, vaultDeposit and vaultDistribution
+	VaultWithdraw         *LedgerVaultWithdraw
+	VaultLeaderCommission *LedgerVaultLeaderCommission
+	SpotTransfer          *LedgerSpotTransfer
+	AccountClassTransfer  *LedgerAccountClassTransfer
+	SpotGenesis           *LedgerSpotGenesis
+	RewardsClaim          *LedgerRewardsClaim
+	Send                  *LedgerSend
+	CStakingTransfer      *LedgerCStakingTransfer
+	Raw                   json.RawMessage
+}
+
+func (d *LedgerDelta) UnmarshalJSON(data []byte) error {
+	var head struct {
+		Type LedgerDeltaType `json:"type"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return err
+	}
+
+	*d = LedgerDelta{Type: head.Type, Raw: append(json.RawMessage(nil), data...)}
+
+	var target any
+	switch head.Type {
+	case LedgerDeltaTypeDeposit:
+		d.Deposit = new(LedgerDeposit)
+		target = d.Deposit
+	case LedgerDeltaTypeWithdraw:
+		d.Withdraw = new(LedgerWithdraw)
+		target = d.Withdraw
+	case LedgerDeltaTypeInternalTransfer:
+		d.InternalTransfer = new(LedgerInternalTransfer)
+		target = d.InternalTransfer
+	case LedgerDeltaTypeSubAccountTransfer:
+		d.SubAccountTransfer = new(LedgerSubAccountTransfer)
+		target = d.SubAccountTransfer
+	case LedgerDeltaTypeLiquidation:
+		d.Liquidation = new(LedgerLiquidation)
+		target = d.Liquidation
+	case LedgerDeltaTypeVaultCreate, LedgerDeltaTypeVaultDeposit, LedgerDeltaTypeVaultDistribution:
+		d.Vault = new(LedgerVaultDelta)
+		target = d.Vault
+	case LedgerDeltaTypeVaultWithdraw:
+		d.VaultWithdraw = new(LedgerVaultWithdraw)
+		target = d.VaultWithdraw
+	case LedgerDeltaTypeVaultLeaderCommission:
+		d.VaultLeaderCommission = new(LedgerVaultLeaderCommission)
+		target = d.VaultLeaderCommission
+	case LedgerDeltaTypeSpotTransfer:
+		d.SpotTransfer = new(LedgerSpotTransfer)
+		target = d.SpotTransfer
+	case LedgerDeltaTypeAccountClassTransfer:
+		d.AccountClassTransfer = new(LedgerAccountClassTransfer)
+		target = d.AccountClassTransfer
+	case LedgerDeltaTypeSpotGenesis:
+		d.SpotGenesis = new(LedgerSpotGenesis)
+		target = d.SpotGenesis
+	case LedgerDeltaTypeRewardsClaim:
+		d.RewardsClaim = new(LedgerRewardsClaim)
+		target = d.RewardsClaim
+	case LedgerDeltaTypeSend:
+		d.Send = new(LedgerSend)
+		target = d.Send
+	case LedgerDeltaTypeCStakingTransfer:
+		d.CStakingTransfer = new(LedgerCStakingTransfer)
+		target = d.CStakingTransfer
+	default:
+		return nil
+	}
+
+	if err := json.Unmarshal(data, target); err != nil {
+		return fmt.Errorf("failed to unmarshal %s ledger delta: %w", head.Type, err)
+	}
+	return nil
+}
+
+func (d LedgerDelta) MarshalJSON() ([]byte, error) {
+	if len(d.Raw) > 0 {
+		return d.Raw, nil
+	}
+
+	variant := d.variant()
+	if variant == nil {
+		return json.Marshal(map[string]any{"type": d.Type})
+	}
+
+	data, err := json.Marshal(variant)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	fields["type"], _ = json.Marshal(d.Type)
+	return json.Marshal(fields)
+}
+
+func (d LedgerDelta) variant() any {
+	switch {
+	case d.Deposit != nil:
+		return d.Deposit
+	case d.Withdraw != nil:
+		return d.Withdraw
+	case d.InternalTransfer != nil:
+		return d.InternalTransfer
+	case d.SubAccountTransfer != nil:
+		return d.SubAccountTransfer
+	case d.Liquidation != nil:
+		return d.Liquidation
+	case d.Vault != nil:
+		return d.Vault
+	case d.VaultWithdraw != nil:
+		return d.VaultWithdraw
+	case d.VaultLeaderCommission != nil:
+		return d.VaultLeaderCommission
+	case d.SpotTransfer != nil:
+		return d.SpotTransfer
+	case d.AccountClassTransfer != nil:
+		return d.AccountClassTransfer
+	case d.SpotGenesis != nil:
+		return d.SpotGenesis
+	case d.RewardsClaim != nil:
+		return d.RewardsClaim
+	case d.Send != nil:
+		return d.Send
+	case d.CStakingTransfer != nil:
+		return d.CStakingTransfer
+	default:
+		return nil
+	}
+}
+
+type LedgerDeposit struct {
+	Usdc string `json:"usdc"`
+}
+
+type LedgerWithdraw struct {
+	Usdc  string `json:"usdc"`
+	Nonce int64  `json:"nonce"`
+	Fee   string `json:"fee"`
+}
+
+type LedgerInternalTransfer struct {
+	Usdc        string `json:"usdc"`
+	User        string `json:"user"`
+	Destination string `json:"destination"`
+	Fee         string `json:"fee"`
+}
+
+type LedgerSubAccountTransfer struct {
+	Usdc        string `json:"usdc"`
+	User        string `json:"user"`
+	Destination string `json:"destination"`
+}
+
+type LedgerLiquidatedPosition struct {
+	Coin string `json:"coin"`
+	Szi  string `json:"szi"`
+}
+
+type LedgerLiquidation struct {
+	LiquidatedNtlPos    string                     `json:"liquidatedNtlPos"`
+	AccountValue        string                     `json:"accountValue"`
+	LeverageType        string                     `json:"leverageType"`
+	LiquidatedPositions []LedgerLiquidatedPosition `json:"liquidatedPositions"`
+}
+
+type LedgerVaultDelta struct {
+	Vault string `json:"vault"`
+	Usdc  string `json:"usdc"`
+}
+
+type LedgerVaultWithdraw struct {
+	Vault           string `json:"vault"`
+	User            string `json:"user"`
+	RequestedUsd    string `json:"requestedUsd"`
+	Commission      string `json:"commission"`
+	ClosingCost     string `json:"closingCost"`
+	Basis           string `json:"basis"`
+	NetWithdrawnUsd string `json:"netWithdrawnUsd"`
+}
+
+type LedgerVaultLeaderCommission struct {
+	User string `json:"user"`
+	Usdc string `json:"usdc"`
+}
+
+type LedgerSpotTransfer struct {
+	Token          string  `json:"token"`
+	Amount         string  `json:"amount"`
+	UsdcValue      string  `json:"usdcValue"`
+	User           string  `json:"user"`
+	Destination    string  `json:"destination"`
+	Fee            string  `json:"fee"`
+	NativeTokenFee *string `json:"nativeTokenFee,omitempty"`
+	Nonce          *int64  `json:"nonce,omitempty"`
+}
+
+type LedgerAccountClassTransfer struct {
+	Usdc   string `json:"usdc"`
+	ToPerp bool   `json:"toPerp"`
+}
+
+type LedgerSpotGenesis struct {
+	Token  string `json:"token"`
+	Amount string `json:"amount"`
+}
+
+type LedgerRewardsClaim struct {
+	Amount string `json:"amount"`
+}
+
+// LedgerSend is a token send between users or between the perp and spot
+// balances of different dexes.
+type LedgerSend struct {
+	User           string `json:"user"`
+	Destination    string `json:"destination"`
+	SourceDex      string `json:"sourceDex"`
+	DestinationDex string `json:"destinationDex"`
+	Token          string `json:"token"`
+	Amount         string `json:"amount"`
+	UsdcValue      string `json:"usdcValue"`
+	Fee            string `json:"fee"`
+	NativeTokenFee string `json:"nativeTokenFee"`
+	Nonce          int64  `json:"nonce"`
+}
+
+// LedgerCStakingTransfer moves tokens between the spot and staking balances
+type LedgerCStakingTransfer struct {
+	Token     string `json:"token"`
+	Amount    string `json:"amount"`
+	IsDeposit bool   `json:"isDeposit"`
+}
+
+// TwapSliceFill is a fill produced by a TWAP slice. It is shared by the
+// userTwapSliceFills info request and websocket channel.
+type TwapSliceFill struct {
+	Fill   Fill  `json:"fill"`
+	TwapID int64 `json:"twapId"`
+}
+
+// TwapState is the state of a TWAP order. Sz is the total size and
+// ExecutedSz the part already filled by slices.
+type TwapState struct {
+	Coin        string `json:"coin"`
+	User        string `json:"user"`
+	Side        string `json:"side"`
+	Sz          string `json:"sz"`
+	ExecutedSz  string `json:"executedSz"`
+	ExecutedNtl string `json:"executedNtl"`
+	Minutes     int    `json:"minutes"`
+	ReduceOnly  bool   `json:"reduceOnly"`
+	Randomize   bool   `json:"randomize"`
+	Timestamp   int64  `json:"timestamp"`
+}
+
+// Progress returns the executed fraction of the TWAP, between 0 and 1
+func (s TwapState) Progress() float64 {
+	sz := parseFloat(s.Sz)
+	if sz == 0 {
+		return 0
+	}
+	return parseFloat(s.ExecutedSz) / sz
+}
+
+// AvgPx returns the average execution price, or 0 while nothing is executed
+func (s TwapState) AvgPx() float64 {
+	executed := parseFloat(s.ExecutedSz)
+	if executed == 0 {
+		return 0
+	}
+	return parseFloat(s.ExecutedNtl) / executed
+}
+
+type TwapStatusValue string
+
+const (
+	TwapStatusActivated  TwapStatusValue = "activated"
+	TwapStatusTerminated TwapStatusValue = "terminated"
+	TwapStatusFinished   TwapStatusValue = "finished"
+	TwapStatusError      TwapStatusValue = "error"
+)
+
+type TwapStatus struct {
+	Status      TwapStatusValue `json:"status"`
+	Description string          `json:"description,omitempty"`
+}
+
+// TwapHistory is a TWAP state transition. Time is expressed in seconds.
+type TwapHistory struct {
+	Time   int64      `json:"time"`
+	State  TwapState  `json:"state"`
+	Status TwapStatus `json:"status"`
+	// TwapID is only set on entries of the twapHistory info request
+	TwapID *int64 `json:"twapId,omitempty"`
+}
@@ -132,7 +132,7 @@ func (v *WsMsg) UnmarshalJSON(data []byte) error {
 func (v *WsMsg) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid1(in *jlexer.Lexer, out *ValidatorResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid1(in *jlexer.Lexer, out *VaultRelationshipData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -151,12 +151,29 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid1(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "status":
-			out.Status = string(in.String())
-		case "txHash":
-			out.TxHash = string(in.String())
-		case "error":
-			out.Error = string(in.String())
+		case "childAddresses":
+			if in.IsNull() {
+				in.Skip()
+				out.ChildAddresses = nil
+			} else {
+				in.Delim('[')
+				if out.ChildAddresses == nil {
+					if !in.IsDelim(']') {
+						out.ChildAddresses = make([]string, 0, 4)
+					} else {
+						out.ChildAddresses = []string{}
+					}
+				} else {
+					out.ChildAddresses = (out.ChildAddresses)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 string
+					v3 = string(in.String())
+					out.ChildAddresses = append(out.ChildAddresses, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -167,52 +184,52 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid1(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid1(out *jwriter.Writer, in ValidatorResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid1(out *jwriter.Writer, in VaultRelationshipData) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"status\":"
+	if len(in.ChildAddresses) != 0 {
+		const prefix string = ",\"childAddresses\":"
+		first = false
 		out.RawString(prefix[1:])
-		out.String(string(in.Status))
-	}
-	if in.TxHash != "" {
-		const prefix string = ",\"txHash\":"
-		out.RawString(prefix)
-		out.String(string(in.TxHash))
-	}
-	if in.Error != "" {
-		const prefix string = ",\"error\":"
-		out.RawString(prefix)
-		out.String(string(in.Error))
+		{
+			out.RawByte('[')
+			for v4, v5 := range in.ChildAddresses {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v5))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ValidatorResponse) MarshalJSON() ([]byte, error) {
+func (v VaultRelationshipData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ValidatorResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v VaultRelationshipData) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ValidatorResponse) UnmarshalJSON(data []byte) error {
+func (v *VaultRelationshipData) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ValidatorResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *VaultRelationshipData) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid1(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid2(in *jlexer.Lexer, out *VIPTier) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid2(in *jlexer.Lexer, out *VaultRelationship) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -231,12 +248,18 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid2(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "add":
-			out.Add = string(in.String())
-		case "cross":
-			out.Cross = string(in.String())
-		case "ntlCutoff":
-			out.NtlCutoff = string(in.String())
+		case "type":
+			out.Type = VaultRelationshipType(in.String())
+		case "data":
+			if in.IsNull() {
+				in.Skip()
+				out.Data = nil
+			} else {
+				if out.Data == nil {
+					out.Data = new(VaultRelationshipData)
+				}
+				(*out.Data).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -247,52 +270,47 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid2(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid2(out *jwriter.Writer, in VIPTier) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid2(out *jwriter.Writer, in VaultRelationship) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"add\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Add))
-	}
-	{
-		const prefix string = ",\"cross\":"
-		out.RawString(prefix)
-		out.String(string(in.Cross))
+		out.String(string(in.Type))
 	}
-	{
-		const prefix string = ",\"ntlCutoff\":"
+	if in.Data != nil {
+		const prefix string = ",\"data\":"
 		out.RawString(prefix)
-		out.String(string(in.NtlCutoff))
+		(*in.Data).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v VIPTier) MarshalJSON() ([]byte, error) {
+func (v VaultRelationship) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v VIPTier) MarshalEasyJSON(w *jwriter.Writer) {
+func (v VaultRelationship) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *VIPTier) UnmarshalJSON(data []byte) error {
+func (v *VaultRelationship) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *VIPTier) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *VaultRelationship) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid2(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid3(in *jlexer.Lexer, out *UserVolume) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid3(in *jlexer.Lexer, out *VaultFollower) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -311,14 +329,20 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid3(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "date":
-			out.Date = string(in.String())
-		case "exchange":
-			out.Exchange = string(in.String())
-		case "userAdd":
-			out.UserAdd = string(in.String())
-		case "userCross":
-			out.UserCross = string(in.String())
+		case "user":
+			out.User = string(in.String())
+		case "vaultEquity":
+			out.VaultEquity = string(in.String())
+		case "pnl":
+			out.Pnl = string(in.String())
+		case "allTimePnl":
+			out.AllTimePnl = string(in.String())
+		case "daysFollowing":
+			out.DaysFollowing = int(in.Int())
+		case "vaultEntryTime":
+			out.VaultEntryTime = int64(in.Int64())
+		case "lockupUntil":
+			out.LockupUntil = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -329,57 +353,72 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid3(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid3(out *jwriter.Writer, in UserVolume) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid3(out *jwriter.Writer, in VaultFollower) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"date\":"
+		const prefix string = ",\"user\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Date))
+		out.String(string(in.User))
 	}
 	{
-		const prefix string = ",\"exchange\":"
+		const prefix string = ",\"vaultEquity\":"
 		out.RawString(prefix)
-		out.String(string(in.Exchange))
+		out.String(string(in.VaultEquity))
 	}
 	{
-		const prefix string = ",\"userAdd\":"
+		const prefix string = ",\"pnl\":"
 		out.RawString(prefix)
-		out.String(string(in.UserAdd))
+		out.String(string(in.Pnl))
 	}
 	{
-		const prefix string = ",\"userCross\":"
+		const prefix string = ",\"allTimePnl\":"
 		out.RawString(prefix)
-		out.String(string(in.UserCross))
+		out.String(string(in.AllTimePnl))
+	}
+	{
+		const prefix string = ",\"daysFollowing\":"
+		out.RawString(prefix)
+		out.Int(int(in.DaysFollowing))
+	}
+	{
+		const prefix string = ",\"vaultEntryTime\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.VaultEntryTime))
+	}
+	{
+		const prefix string = ",\"lockupUntil\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LockupUntil))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserVolume) MarshalJSON() ([]byte, error) {
+func (v VaultFollower) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserVolume) MarshalEasyJSON(w *jwriter.Writer) {
+func (v VaultFollower) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserVolume) UnmarshalJSON(data []byte) error {
+func (v *VaultFollower) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserVolume) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *VaultFollower) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid3(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid4(in *jlexer.Lexer, out *UserState) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid4(in *jlexer.Lexer, out *ValidatorResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -398,35 +437,12 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid4(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "assetPositions":
-			if in.IsNull() {
-				in.Skip()
-				out.AssetPositions = nil
-			} else {
-				in.Delim('[')
-				if out.AssetPositions == nil {
-					if !in.IsDelim(']') {
-						out.AssetPositions = make([]AssetPosition, 0, 0)
-					} else {
-						out.AssetPositions = []AssetPosition{}
-					}
-				} else {
-					out.AssetPositions = (out.AssetPositions)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v3 AssetPosition
-					(v3).UnmarshalEasyJSON(in)
-					out.AssetPositions = append(out.AssetPositions, v3)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "crossMarginSummary":
-			(out.CrossMarginSummary).UnmarshalEasyJSON(in)
-		case "marginSummary":
-			(out.MarginSummary).UnmarshalEasyJSON(in)
-		case "withdrawable":
-			out.Withdrawable = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "txHash":
+			out.TxHash = string(in.String())
+		case "error":
+			out.Error = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -437,68 +453,52 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid4(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid4(out *jwriter.Writer, in UserState) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid4(out *jwriter.Writer, in ValidatorResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"assetPositions\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix[1:])
-		if in.AssetPositions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v4, v5 := range in.AssetPositions {
-				if v4 > 0 {
-					out.RawByte(',')
-				}
-				(v5).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"crossMarginSummary\":"
-		out.RawString(prefix)
-		(in.CrossMarginSummary).MarshalEasyJSON(out)
+		out.String(string(in.Status))
 	}
-	{
-		const prefix string = ",\"marginSummary\":"
+	if in.TxHash != "" {
+		const prefix string = ",\"txHash\":"
 		out.RawString(prefix)
-		(in.MarginSummary).MarshalEasyJSON(out)
+		out.String(string(in.TxHash))
 	}
-	{
-		const prefix string = ",\"withdrawable\":"
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
 		out.RawString(prefix)
-		out.String(string(in.Withdrawable))
+		out.String(string(in.Error))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserState) MarshalJSON() ([]byte, error) {
+func (v ValidatorResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserState) MarshalEasyJSON(w *jwriter.Writer) {
+func (v ValidatorResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserState) UnmarshalJSON(data []byte) error {
+func (v *ValidatorResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserState) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *ValidatorResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid4(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid5(in *jlexer.Lexer, out *UserFundingHistory) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid5(in *jlexer.Lexer, out *VIPTier) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -517,14 +517,12 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid5(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "user":
-			out.User = string(in.String())
-		case "type":
-			out.Type = string(in.String())
-		case "startTime":
-			out.StartTime = int64(in.Int64())
-		case "endTime":
-			out.EndTime = int64(in.Int64())
+		case "add":
+			out.Add = string(in.String())
+		case "cross":
+			out.Cross = string(in.String())
+		case "ntlCutoff":
+			out.NtlCutoff = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -535,57 +533,52 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid5(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid5(out *jwriter.Writer, in UserFundingHistory) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid5(out *jwriter.Writer, in VIPTier) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user\":"
+		const prefix string = ",\"add\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.User))
-	}
-	{
-		const prefix string = ",\"type\":"
-		out.RawString(prefix)
-		out.String(string(in.Type))
+		out.String(string(in.Add))
 	}
 	{
-		const prefix string = ",\"startTime\":"
+		const prefix string = ",\"cross\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.StartTime))
+		out.String(string(in.Cross))
 	}
 	{
-		const prefix string = ",\"endTime\":"
+		const prefix string = ",\"ntlCutoff\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.EndTime))
+		out.String(string(in.NtlCutoff))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserFundingHistory) MarshalJSON() ([]byte, error) {
+func (v VIPTier) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserFundingHistory) MarshalEasyJSON(w *jwriter.Writer) {
+func (v VIPTier) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserFundingHistory) UnmarshalJSON(data []byte) error {
+func (v *VIPTier) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserFundingHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *VIPTier) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid5(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid6(in *jlexer.Lexer, out *UserFees) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid6(in *jlexer.Lexer, out *UserVolume) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -604,41 +597,14 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid6(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "activeReferralDiscount":
-			out.ActiveReferralDiscount = string(in.String())
-		case "dailyUserVlm":
-			if in.IsNull() {
-				in.Skip()
-				out.DailyUserVolume = nil
-			} else {
-				in.Delim('[')
-				if out.DailyUserVolume == nil {
-					if !in.IsDelim(']') {
-						out.DailyUserVolume = make([]UserVolume, 0, 1)
-					} else {
-						out.DailyUserVolume = []UserVolume{}
-					}
-				} else {
-					out.DailyUserVolume = (out.DailyUserVolume)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v6 UserVolume
-					(v6).UnmarshalEasyJSON(in)
-					out.DailyUserVolume = append(out.DailyUserVolume, v6)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "feeSchedule":
-			(out.FeeSchedule).UnmarshalEasyJSON(in)
-		case "userAddRate":
-			out.UserAddRate = string(in.String())
-		case "userCrossRate":
-			out.UserCrossRate = string(in.String())
-		case "userSpotCrossRate":
-			out.UserSpotCrossRate = string(in.String())
-		case "userSpotAddRate":
-			out.UserSpotAddRate = string(in.String())
+		case "date":
+			out.Date = string(in.String())
+		case "exchange":
+			out.Exchange = string(in.String())
+		case "userAdd":
+			out.UserAdd = string(in.String())
+		case "userCross":
+			out.UserCross = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -649,83 +615,57 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid6(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid6(out *jwriter.Writer, in UserFees) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid6(out *jwriter.Writer, in UserVolume) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"activeReferralDiscount\":"
+		const prefix string = ",\"date\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ActiveReferralDiscount))
-	}
-	{
-		const prefix string = ",\"dailyUserVlm\":"
-		out.RawString(prefix)
-		if in.DailyUserVolume == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v7, v8 := range in.DailyUserVolume {
-				if v7 > 0 {
-					out.RawByte(',')
-				}
-				(v8).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"feeSchedule\":"
-		out.RawString(prefix)
-		(in.FeeSchedule).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"userAddRate\":"
-		out.RawString(prefix)
-		out.String(string(in.UserAddRate))
+		out.String(string(in.Date))
 	}
 	{
-		const prefix string = ",\"userCrossRate\":"
+		const prefix string = ",\"exchange\":"
 		out.RawString(prefix)
-		out.String(string(in.UserCrossRate))
+		out.String(string(in.Exchange))
 	}
 	{
-		const prefix string = ",\"userSpotCrossRate\":"
+		const prefix string = ",\"userAdd\":"
 		out.RawString(prefix)
-		out.String(string(in.UserSpotCrossRate))
+		out.String(string(in.UserAdd))
 	}
 	{
-		const prefix string = ",\"userSpotAddRate\":"
+		const prefix string = ",\"userCross\":"
 		out.RawString(prefix)
-		out.String(string(in.UserSpotAddRate))
+		out.String(string(in.UserCross))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserFees) MarshalJSON() ([]byte, error) {
+func (v UserVolume) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserFees) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserVolume) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserFees) UnmarshalJSON(data []byte) error {
+func (v *UserVolume) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserFees) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserVolume) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid6(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid7(in *jlexer.Lexer, out *UserActiveAssetData) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid7(in *jlexer.Lexer, out *UserVaultEquity) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -744,60 +684,12 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid7(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "user":
-			out.User = string(in.String())
-		case "coin":
-			out.Coin = string(in.String())
-		case "leverage":
-			(out.Leverage).UnmarshalEasyJSON(in)
-		case "maxTradeSzs":
-			if in.IsNull() {
-				in.Skip()
-				out.MaxTradeSzs = nil
-			} else {
-				in.Delim('[')
-				if out.MaxTradeSzs == nil {
-					if !in.IsDelim(']') {
-						out.MaxTradeSzs = make([]string, 0, 4)
-					} else {
-						out.MaxTradeSzs = []string{}
-					}
-				} else {
-					out.MaxTradeSzs = (out.MaxTradeSzs)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v9 string
-					v9 = string(in.String())
-					out.MaxTradeSzs = append(out.MaxTradeSzs, v9)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "availableToTrade":
-			if in.IsNull() {
-				in.Skip()
-				out.AvailableToTrade = nil
-			} else {
-				in.Delim('[')
-				if out.AvailableToTrade == nil {
-					if !in.IsDelim(']') {
-						out.AvailableToTrade = make([]string, 0, 4)
-					} else {
-						out.AvailableToTrade = []string{}
-					}
-				} else {
-					out.AvailableToTrade = (out.AvailableToTrade)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v10 string
-					v10 = string(in.String())
-					out.AvailableToTrade = append(out.AvailableToTrade, v10)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "markPx":
-			out.MarkPx = string(in.String())
+		case "vaultAddress":
+			out.VaultAddress = string(in.String())
+		case "equity":
+			out.Equity = string(in.String())
+		case "lockedUntilTimestamp":
+			out.LockedUntilTimestamp = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -808,89 +700,52 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid7(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid7(out *jwriter.Writer, in UserActiveAssetData) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid7(out *jwriter.Writer, in UserVaultEquity) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user\":"
+		const prefix string = ",\"vaultAddress\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.User))
-	}
-	{
-		const prefix string = ",\"coin\":"
-		out.RawString(prefix)
-		out.String(string(in.Coin))
-	}
-	{
-		const prefix string = ",\"leverage\":"
-		out.RawString(prefix)
-		(in.Leverage).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"maxTradeSzs\":"
-		out.RawString(prefix)
-		if in.MaxTradeSzs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v11, v12 := range in.MaxTradeSzs {
-				if v11 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v12))
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.VaultAddress))
 	}
 	{
-		const prefix string = ",\"availableToTrade\":"
+		const prefix string = ",\"equity\":"
 		out.RawString(prefix)
-		if in.AvailableToTrade == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v13, v14 := range in.AvailableToTrade {
-				if v13 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v14))
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Equity))
 	}
-	{
-		const prefix string = ",\"markPx\":"
+	if in.LockedUntilTimestamp != 0 {
+		const prefix string = ",\"lockedUntilTimestamp\":"
 		out.RawString(prefix)
-		out.String(string(in.MarkPx))
+		out.Int64(int64(in.LockedUntilTimestamp))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserActiveAssetData) MarshalJSON() ([]byte, error) {
+func (v UserVaultEquity) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserActiveAssetData) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserVaultEquity) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserActiveAssetData) UnmarshalJSON(data []byte) error {
+func (v *UserVaultEquity) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserActiveAssetData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserVaultEquity) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid7(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid8(in *jlexer.Lexer, out *TxStatus) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid8(in *jlexer.Lexer, out *UserState) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -909,10 +764,35 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid8(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "coin":
-			out.Coin = string(in.String())
-		case "status":
-			out.Status = string(in.String())
+		case "assetPositions":
+			if in.IsNull() {
+				in.Skip()
+				out.AssetPositions = nil
+			} else {
+				in.Delim('[')
+				if out.AssetPositions == nil {
+					if !in.IsDelim(']') {
+						out.AssetPositions = make([]AssetPosition, 0, 0)
+					} else {
+						out.AssetPositions = []AssetPosition{}
+					}
+				} else {
+					out.AssetPositions = (out.AssetPositions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v6 AssetPosition
+					(v6).UnmarshalEasyJSON(in)
+					out.AssetPositions = append(out.AssetPositions, v6)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "crossMarginSummary":
+			(out.CrossMarginSummary).UnmarshalEasyJSON(in)
+		case "marginSummary":
+			(out.MarginSummary).UnmarshalEasyJSON(in)
+		case "withdrawable":
+			out.Withdrawable = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -923,47 +803,68 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid8(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid8(out *jwriter.Writer, in TxStatus) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid8(out *jwriter.Writer, in UserState) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"coin\":"
+		const prefix string = ",\"assetPositions\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Coin))
+		if in.AssetPositions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v7, v8 := range in.AssetPositions {
+				if v7 > 0 {
+					out.RawByte(',')
+				}
+				(v8).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"crossMarginSummary\":"
 		out.RawString(prefix)
-		out.String(string(in.Status))
+		(in.CrossMarginSummary).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"marginSummary\":"
+		out.RawString(prefix)
+		(in.MarginSummary).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"withdrawable\":"
+		out.RawString(prefix)
+		out.String(string(in.Withdrawable))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TxStatus) MarshalJSON() ([]byte, error) {
+func (v UserState) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TxStatus) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserState) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TxStatus) UnmarshalJSON(data []byte) error {
+func (v *UserState) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TxStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserState) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid8(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid9(in *jlexer.Lexer, out *TriggerOrderType) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid9(in *jlexer.Lexer, out *UserRoleData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -982,12 +883,10 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid9(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "triggerPx":
-			out.TriggerPx = float64(in.Float64())
-		case "isMarket":
-			out.IsMarket = bool(in.Bool())
-		case "tpsl":
-			out.Tpsl = string(in.String())
+		case "user":
+			out.User = string(in.String())
+		case "master":
+			out.Master = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -998,52 +897,53 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid9(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid9(out *jwriter.Writer, in TriggerOrderType) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid9(out *jwriter.Writer, in UserRoleData) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"triggerPx\":"
+	if in.User != "" {
+		const prefix string = ",\"user\":"
+		first = false
 		out.RawString(prefix[1:])
-		out.Float64(float64(in.TriggerPx))
-	}
-	{
-		const prefix string = ",\"isMarket\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsMarket))
+		out.String(string(in.User))
 	}
-	{
-		const prefix string = ",\"tpsl\":"
-		out.RawString(prefix)
-		out.String(string(in.Tpsl))
+	if in.Master != "" {
+		const prefix string = ",\"master\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Master))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TriggerOrderType) MarshalJSON() ([]byte, error) {
+func (v UserRoleData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TriggerOrderType) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserRoleData) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TriggerOrderType) UnmarshalJSON(data []byte) error {
+func (v *UserRoleData) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TriggerOrderType) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserRoleData) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid9(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid10(in *jlexer.Lexer, out *TransferResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid10(in *jlexer.Lexer, out *UserRole) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1062,68 +962,69 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid10(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "status":
-			out.Status = string(in.String())
-		case "txHash":
-			out.TxHash = string(in.String())
-		case "error":
-			out.Error = string(in.String())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
+		case "role":
+			out.Role = UserRoleType(in.String())
+		case "data":
+			if in.IsNull() {
+				in.Skip()
+				out.Data = nil
+			} else {
+				if out.Data == nil {
+					out.Data = new(UserRoleData)
+				}
+				(*out.Data).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid10(out *jwriter.Writer, in TransferResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid10(out *jwriter.Writer, in UserRole) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"role\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Status))
-	}
-	if in.TxHash != "" {
-		const prefix string = ",\"txHash\":"
-		out.RawString(prefix)
-		out.String(string(in.TxHash))
+		out.String(string(in.Role))
 	}
-	if in.Error != "" {
-		const prefix string = ",\"error\":"
+	if in.Data != nil {
+		const prefix string = ",\"data\":"
 		out.RawString(prefix)
-		out.String(string(in.Error))
+		(*in.Data).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TransferResponse) MarshalJSON() ([]byte, error) {
+func (v UserRole) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TransferResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserRole) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TransferResponse) UnmarshalJSON(data []byte) error {
+func (v *UserRole) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TransferResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserRole) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid10(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid11(in *jlexer.Lexer, out *Tiers) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid11(in *jlexer.Lexer, out *UserRateLimit) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1142,52 +1043,14 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid11(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "mm":
-			if in.IsNull() {
-				in.Skip()
-				out.MM = nil
-			} else {
-				in.Delim('[')
-				if out.MM == nil {
-					if !in.IsDelim(']') {
-						out.MM = make([]MMTier, 0, 2)
-					} else {
-						out.MM = []MMTier{}
-					}
-				} else {
-					out.MM = (out.MM)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v15 MMTier
-					(v15).UnmarshalEasyJSON(in)
-					out.MM = append(out.MM, v15)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "vip":
-			if in.IsNull() {
-				in.Skip()
-				out.VIP = nil
-			} else {
-				in.Delim('[')
-				if out.VIP == nil {
-					if !in.IsDelim(']') {
-						out.VIP = make([]VIPTier, 0, 1)
-					} else {
-						out.VIP = []VIPTier{}
-					}
-				} else {
-					out.VIP = (out.VIP)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v16 VIPTier
-					(v16).UnmarshalEasyJSON(in)
-					out.VIP = append(out.VIP, v16)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "cumVlm":
+			out.CumVlm = string(in.String())
+		case "nRequestsUsed":
+			out.NRequestsUsed = int64(in.Int64())
+		case "nRequestsCap":
+			out.NRequestsCap = int64(in.Int64())
+		case "nRequestsSurplus":
+			out.NRequestsSurplus = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -1198,69 +1061,57 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid11(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid11(out *jwriter.Writer, in Tiers) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid11(out *jwriter.Writer, in UserRateLimit) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"mm\":"
+		const prefix string = ",\"cumVlm\":"
 		out.RawString(prefix[1:])
-		if in.MM == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v17, v18 := range in.MM {
-				if v17 > 0 {
-					out.RawByte(',')
-				}
-				(v18).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.CumVlm))
 	}
 	{
-		const prefix string = ",\"vip\":"
+		const prefix string = ",\"nRequestsUsed\":"
 		out.RawString(prefix)
-		if in.VIP == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v19, v20 := range in.VIP {
-				if v19 > 0 {
-					out.RawByte(',')
-				}
-				(v20).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.Int64(int64(in.NRequestsUsed))
+	}
+	{
+		const prefix string = ",\"nRequestsCap\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.NRequestsCap))
+	}
+	if in.NRequestsSurplus != 0 {
+		const prefix string = ",\"nRequestsSurplus\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.NRequestsSurplus))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Tiers) MarshalJSON() ([]byte, error) {
+func (v UserRateLimit) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Tiers) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserRateLimit) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Tiers) UnmarshalJSON(data []byte) error {
+func (v *UserRateLimit) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Tiers) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserRateLimit) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid11(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid12(in *jlexer.Lexer, out *SubAccount) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid12(in *jlexer.Lexer, out *UserFundingHistory) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1279,33 +1130,12 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid12(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "user":
-			out.User = string(in.String())
-		case "permissions":
-			if in.IsNull() {
-				in.Skip()
-				out.Permissions = nil
-			} else {
-				in.Delim('[')
-				if out.Permissions == nil {
-					if !in.IsDelim(']') {
-						out.Permissions = make([]string, 0, 4)
-					} else {
-						out.Permissions = []string{}
-					}
-				} else {
-					out.Permissions = (out.Permissions)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v21 string
-					v21 = string(in.String())
-					out.Permissions = append(out.Permissions, v21)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "time":
+			out.Time = int64(in.Int64())
+		case "hash":
+			out.Hash = string(in.String())
+		case "delta":
+			(out.Delta).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -1316,63 +1146,52 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid12(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid12(out *jwriter.Writer, in SubAccount) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid12(out *jwriter.Writer, in UserFundingHistory) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"time\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
+		out.Int64(int64(in.Time))
 	}
 	{
-		const prefix string = ",\"user\":"
+		const prefix string = ",\"hash\":"
 		out.RawString(prefix)
-		out.String(string(in.User))
+		out.String(string(in.Hash))
 	}
 	{
-		const prefix string = ",\"permissions\":"
+		const prefix string = ",\"delta\":"
 		out.RawString(prefix)
-		if in.Permissions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v22, v23 := range in.Permissions {
-				if v22 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v23))
-			}
-			out.RawByte(']')
-		}
+		(in.Delta).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SubAccount) MarshalJSON() ([]byte, error) {
+func (v UserFundingHistory) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid12(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SubAccount) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserFundingHistory) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid12(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SubAccount) UnmarshalJSON(data []byte) error {
+func (v *UserFundingHistory) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid12(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SubAccount) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserFundingHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid12(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid13(in *jlexer.Lexer, out *StakingSummary) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid13(in *jlexer.Lexer, out *UserFundingDelta) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1391,14 +1210,26 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid13(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "delegated":
-			out.Delegated = string(in.String())
-		case "undelegated":
-			out.Undelegated = string(in.String())
-		case "totalPendingWithdrawal":
-			out.TotalPendingWithdrawal = string(in.String())
-		case "nPendingWithdrawals":
-			out.NPendingWithdrawals = int(in.Int())
+		case "type":
+			out.Type = string(in.String())
+		case "coin":
+			out.Coin = string(in.String())
+		case "usdc":
+			out.Usdc = string(in.String())
+		case "szi":
+			out.Szi = string(in.String())
+		case "fundingRate":
+			out.FundingRate = string(in.String())
+		case "nSamples":
+			if in.IsNull() {
+				in.Skip()
+				out.NSamples = nil
+			} else {
+				if out.NSamples == nil {
+					out.NSamples = new(int)
+				}
+				*out.NSamples = int(in.Int())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1409,57 +1240,71 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid13(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid13(out *jwriter.Writer, in StakingSummary) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid13(out *jwriter.Writer, in UserFundingDelta) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"delegated\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Delegated))
+		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"undelegated\":"
+		const prefix string = ",\"coin\":"
 		out.RawString(prefix)
-		out.String(string(in.Undelegated))
+		out.String(string(in.Coin))
 	}
 	{
-		const prefix string = ",\"totalPendingWithdrawal\":"
+		const prefix string = ",\"usdc\":"
 		out.RawString(prefix)
-		out.String(string(in.TotalPendingWithdrawal))
+		out.String(string(in.Usdc))
 	}
 	{
-		const prefix string = ",\"nPendingWithdrawals\":"
+		const prefix string = ",\"szi\":"
 		out.RawString(prefix)
-		out.Int(int(in.NPendingWithdrawals))
+		out.String(string(in.Szi))
+	}
+	{
+		const prefix string = ",\"fundingRate\":"
+		out.RawString(prefix)
+		out.String(string(in.FundingRate))
+	}
+	{
+		const prefix string = ",\"nSamples\":"
+		out.RawString(prefix)
+		if in.NSamples == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.NSamples))
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v StakingSummary) MarshalJSON() ([]byte, error) {
+func (v UserFundingDelta) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid13(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v StakingSummary) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserFundingDelta) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid13(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *StakingSummary) UnmarshalJSON(data []byte) error {
+func (v *UserFundingDelta) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid13(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *StakingSummary) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserFundingDelta) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid13(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid14(in *jlexer.Lexer, out *StakingReward) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid14(in *jlexer.Lexer, out *UserFees) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1478,12 +1323,41 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid14(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "time":
-			out.Time = int64(in.Int64())
-		case "source":
-			out.Source = string(in.String())
-		case "totalAmount":
-			out.TotalAmount = string(in.String())
+		case "activeReferralDiscount":
+			out.ActiveReferralDiscount = string(in.String())
+		case "dailyUserVlm":
+			if in.IsNull() {
+				in.Skip()
+				out.DailyUserVolume = nil
+			} else {
+				in.Delim('[')
+				if out.DailyUserVolume == nil {
+					if !in.IsDelim(']') {
+						out.DailyUserVolume = make([]UserVolume, 0, 1)
+					} else {
+						out.DailyUserVolume = []UserVolume{}
+					}
+				} else {
+					out.DailyUserVolume = (out.DailyUserVolume)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v9 UserVolume
+					(v9).UnmarshalEasyJSON(in)
+					out.DailyUserVolume = append(out.DailyUserVolume, v9)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "feeSchedule":
+			(out.FeeSchedule).UnmarshalEasyJSON(in)
+		case "userAddRate":
+			out.UserAddRate = string(in.String())
+		case "userCrossRate":
+			out.UserCrossRate = string(in.String())
+		case "userSpotCrossRate":
+			out.UserSpotCrossRate = string(in.String())
+		case "userSpotAddRate":
+			out.UserSpotAddRate = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1494,52 +1368,83 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid14(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid14(out *jwriter.Writer, in StakingReward) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid14(out *jwriter.Writer, in UserFees) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"time\":"
+		const prefix string = ",\"activeReferralDiscount\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.Time))
-	}
-	{
-		const prefix string = ",\"source\":"
-		out.RawString(prefix)
-		out.String(string(in.Source))
+		out.String(string(in.ActiveReferralDiscount))
 	}
 	{
-		const prefix string = ",\"totalAmount\":"
+		const prefix string = ",\"dailyUserVlm\":"
 		out.RawString(prefix)
-		out.String(string(in.TotalAmount))
+		if in.DailyUserVolume == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v10, v11 := range in.DailyUserVolume {
+				if v10 > 0 {
+					out.RawByte(',')
+				}
+				(v11).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"feeSchedule\":"
+		out.RawString(prefix)
+		(in.FeeSchedule).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"userAddRate\":"
+		out.RawString(prefix)
+		out.String(string(in.UserAddRate))
+	}
+	{
+		const prefix string = ",\"userCrossRate\":"
+		out.RawString(prefix)
+		out.String(string(in.UserCrossRate))
+	}
+	{
+		const prefix string = ",\"userSpotCrossRate\":"
+		out.RawString(prefix)
+		out.String(string(in.UserSpotCrossRate))
+	}
+	{
+		const prefix string = ",\"userSpotAddRate\":"
+		out.RawString(prefix)
+		out.String(string(in.UserSpotAddRate))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v StakingReward) MarshalJSON() ([]byte, error) {
+func (v UserFees) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid14(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v StakingReward) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserFees) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid14(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *StakingReward) UnmarshalJSON(data []byte) error {
+func (v *UserFees) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid14(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *StakingReward) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserFees) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid14(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid15(in *jlexer.Lexer, out *StakingDelegation) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid15(in *jlexer.Lexer, out *UserActiveAssetData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1558,12 +1463,60 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid15(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "validator":
-			out.Validator = string(in.String())
-		case "amount":
-			out.Amount = string(in.String())
-		case "lockedUntilTimestamp":
-			out.LockedUntilTimestamp = int64(in.Int64())
+		case "user":
+			out.User = string(in.String())
+		case "coin":
+			out.Coin = string(in.String())
+		case "leverage":
+			(out.Leverage).UnmarshalEasyJSON(in)
+		case "maxTradeSzs":
+			if in.IsNull() {
+				in.Skip()
+				out.MaxTradeSzs = nil
+			} else {
+				in.Delim('[')
+				if out.MaxTradeSzs == nil {
+					if !in.IsDelim(']') {
+						out.MaxTradeSzs = make([]string, 0, 4)
+					} else {
+						out.MaxTradeSzs = []string{}
+					}
+				} else {
+					out.MaxTradeSzs = (out.MaxTradeSzs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v12 string
+					v12 = string(in.String())
+					out.MaxTradeSzs = append(out.MaxTradeSzs, v12)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "availableToTrade":
+			if in.IsNull() {
+				in.Skip()
+				out.AvailableToTrade = nil
+			} else {
+				in.Delim('[')
+				if out.AvailableToTrade == nil {
+					if !in.IsDelim(']') {
+						out.AvailableToTrade = make([]string, 0, 4)
+					} else {
+						out.AvailableToTrade = []string{}
+					}
+				} else {
+					out.AvailableToTrade = (out.AvailableToTrade)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 string
+					v13 = string(in.String())
+					out.AvailableToTrade = append(out.AvailableToTrade, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "markPx":
+			out.MarkPx = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1574,52 +1527,89 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid15(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid15(out *jwriter.Writer, in StakingDelegation) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid15(out *jwriter.Writer, in UserActiveAssetData) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"validator\":"
+		const prefix string = ",\"user\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Validator))
+		out.String(string(in.User))
 	}
 	{
-		const prefix string = ",\"amount\":"
+		const prefix string = ",\"coin\":"
 		out.RawString(prefix)
-		out.String(string(in.Amount))
+		out.String(string(in.Coin))
 	}
 	{
-		const prefix string = ",\"lockedUntilTimestamp\":"
+		const prefix string = ",\"leverage\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.LockedUntilTimestamp))
+		(in.Leverage).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"maxTradeSzs\":"
+		out.RawString(prefix)
+		if in.MaxTradeSzs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.MaxTradeSzs {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v15))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"availableToTrade\":"
+		out.RawString(prefix)
+		if in.AvailableToTrade == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v16, v17 := range in.AvailableToTrade {
+				if v16 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v17))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"markPx\":"
+		out.RawString(prefix)
+		out.String(string(in.MarkPx))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v StakingDelegation) MarshalJSON() ([]byte, error) {
+func (v UserActiveAssetData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid15(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v StakingDelegation) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserActiveAssetData) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid15(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *StakingDelegation) UnmarshalJSON(data []byte) error {
+func (v *UserActiveAssetData) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid15(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *StakingDelegation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserActiveAssetData) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid15(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid16(in *jlexer.Lexer, out *SpotUserState) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid16(in *jlexer.Lexer, out *TxStatus) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1638,29 +1628,10 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid16(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "balances":
-			if in.IsNull() {
-				in.Skip()
-				out.Balances = nil
-			} else {
-				in.Delim('[')
-				if out.Balances == nil {
-					if !in.IsDelim(']') {
-						out.Balances = make([]SpotBalance, 0, 0)
-					} else {
-						out.Balances = []SpotBalance{}
-					}
-				} else {
-					out.Balances = (out.Balances)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v24 SpotBalance
-					(v24).UnmarshalEasyJSON(in)
-					out.Balances = append(out.Balances, v24)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "coin":
+			out.Coin = string(in.String())
+		case "status":
+			out.Status = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1671,53 +1642,47 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid16(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid16(out *jwriter.Writer, in SpotUserState) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid16(out *jwriter.Writer, in TxStatus) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"balances\":"
+		const prefix string = ",\"coin\":"
 		out.RawString(prefix[1:])
-		if in.Balances == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v25, v26 := range in.Balances {
-				if v25 > 0 {
-					out.RawByte(',')
-				}
-				(v26).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Coin))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SpotUserState) MarshalJSON() ([]byte, error) {
+func (v TxStatus) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid16(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SpotUserState) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TxStatus) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid16(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SpotUserState) UnmarshalJSON(data []byte) error {
+func (v *TxStatus) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid16(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SpotUserState) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TxStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid16(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid17(in *jlexer.Lexer, out *SpotTokenInfo) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid17(in *jlexer.Lexer, out *TwapStatus) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1736,38 +1701,10 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid17(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "szDecimals":
-			out.SzDecimals = int(in.Int())
-		case "weiDecimals":
-			out.WeiDecimals = int(in.Int())
-		case "index":
-			out.Index = int(in.Int())
-		case "tokenId":
-			out.TokenID = string(in.String())
-		case "isCanonical":
-			out.IsCanonical = bool(in.Bool())
-		case "evmContract":
-			if in.IsNull() {
-				in.Skip()
-				out.EvmContract = nil
-			} else {
-				if out.EvmContract == nil {
-					out.EvmContract = new(EvmContract)
-				}
-				(*out.EvmContract).UnmarshalEasyJSON(in)
-			}
-		case "fullName":
-			if in.IsNull() {
-				in.Skip()
-				out.FullName = nil
-			} else {
-				if out.FullName == nil {
-					out.FullName = new(string)
-				}
-				*out.FullName = string(in.String())
-			}
+		case "status":
+			out.Status = TwapStatusValue(in.String())
+		case "description":
+			out.Description = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1778,85 +1715,47 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid17(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid17(out *jwriter.Writer, in SpotTokenInfo) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid17(out *jwriter.Writer, in TwapStatus) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"szDecimals\":"
-		out.RawString(prefix)
-		out.Int(int(in.SzDecimals))
-	}
-	{
-		const prefix string = ",\"weiDecimals\":"
-		out.RawString(prefix)
-		out.Int(int(in.WeiDecimals))
+		out.String(string(in.Status))
 	}
-	{
-		const prefix string = ",\"index\":"
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
 		out.RawString(prefix)
-		out.Int(int(in.Index))
-	}
-	{
-		const prefix string = ",\"tokenId\":"
-		out.RawString(prefix)
-		out.String(string(in.TokenID))
-	}
-	{
-		const prefix string = ",\"isCanonical\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsCanonical))
-	}
-	{
-		const prefix string = ",\"evmContract\":"
-		out.RawString(prefix)
-		if in.EvmContract == nil {
-			out.RawString("null")
-		} else {
-			(*in.EvmContract).MarshalEasyJSON(out)
-		}
-	}
-	{
-		const prefix string = ",\"fullName\":"
-		out.RawString(prefix)
-		if in.FullName == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.FullName))
-		}
+		out.String(string(in.Description))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SpotTokenInfo) MarshalJSON() ([]byte, error) {
+func (v TwapStatus) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid17(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SpotTokenInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TwapStatus) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid17(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SpotTokenInfo) UnmarshalJSON(data []byte) error {
+func (v *TwapStatus) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid17(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SpotTokenInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TwapStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid17(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid18(in *jlexer.Lexer, out *SpotMetaAndAssetCtxs) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid18(in *jlexer.Lexer, out *TwapState) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1875,31 +1774,26 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid18(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "Meta":
-			(out.Meta).UnmarshalEasyJSON(in)
-		case "Ctxs":
-			if in.IsNull() {
-				in.Skip()
-				out.Ctxs = nil
-			} else {
-				in.Delim('[')
-				if out.Ctxs == nil {
-					if !in.IsDelim(']') {
-						out.Ctxs = make([]SpotAssetCtx, 0, 0)
-					} else {
-						out.Ctxs = []SpotAssetCtx{}
-					}
-				} else {
-					out.Ctxs = (out.Ctxs)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v27 SpotAssetCtx
-					(v27).UnmarshalEasyJSON(in)
-					out.Ctxs = append(out.Ctxs, v27)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "coin":
+			out.Coin = string(in.String())
+		case "user":
+			out.User = string(in.String())
+		case "side":
+			out.Side = string(in.String())
+		case "sz":
+			out.Sz = string(in.String())
+		case "executedSz":
+			out.ExecutedSz = string(in.String())
+		case "executedNtl":
+			out.ExecutedNtl = string(in.String())
+		case "minutes":
+			out.Minutes = int(in.Int())
+		case "reduceOnly":
+			out.ReduceOnly = bool(in.Bool())
+		case "randomize":
+			out.Randomize = bool(in.Bool())
+		case "timestamp":
+			out.Timestamp = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -1910,58 +1804,87 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid18(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid18(out *jwriter.Writer, in SpotMetaAndAssetCtxs) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid18(out *jwriter.Writer, in TwapState) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Meta\":"
+		const prefix string = ",\"coin\":"
 		out.RawString(prefix[1:])
-		(in.Meta).MarshalEasyJSON(out)
+		out.String(string(in.Coin))
 	}
 	{
-		const prefix string = ",\"Ctxs\":"
+		const prefix string = ",\"user\":"
 		out.RawString(prefix)
-		if in.Ctxs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v28, v29 := range in.Ctxs {
-				if v28 > 0 {
-					out.RawByte(',')
-				}
-				(v29).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.User))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"sz\":"
+		out.RawString(prefix)
+		out.String(string(in.Sz))
+	}
+	{
+		const prefix string = ",\"executedSz\":"
+		out.RawString(prefix)
+		out.String(string(in.ExecutedSz))
+	}
+	{
+		const prefix string = ",\"executedNtl\":"
+		out.RawString(prefix)
+		out.String(string(in.ExecutedNtl))
+	}
+	{
+		const prefix string = ",\"minutes\":"
+		out.RawString(prefix)
+		out.Int(int(in.Minutes))
+	}
+	{
+		const prefix string = ",\"reduceOnly\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReduceOnly))
+	}
+	{
+		const prefix string = ",\"randomize\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Randomize))
+	}
+	{
+		const prefix string = ",\"timestamp\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Timestamp))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SpotMetaAndAssetCtxs) MarshalJSON() ([]byte, error) {
+func (v TwapState) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid18(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SpotMetaAndAssetCtxs) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TwapState) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid18(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SpotMetaAndAssetCtxs) UnmarshalJSON(data []byte) error {
+func (v *TwapState) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid18(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SpotMetaAndAssetCtxs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TwapState) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid18(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid19(in *jlexer.Lexer, out *SpotMeta) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid19(in *jlexer.Lexer, out *TwapSliceFill) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1980,52 +1903,10 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid19(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "universe":
-			if in.IsNull() {
-				in.Skip()
-				out.Universe = nil
-			} else {
-				in.Delim('[')
-				if out.Universe == nil {
-					if !in.IsDelim(']') {
-						out.Universe = make([]SpotAssetInfo, 0, 1)
-					} else {
-						out.Universe = []SpotAssetInfo{}
-					}
-				} else {
-					out.Universe = (out.Universe)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v30 SpotAssetInfo
-					(v30).UnmarshalEasyJSON(in)
-					out.Universe = append(out.Universe, v30)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "tokens":
-			if in.IsNull() {
-				in.Skip()
-				out.Tokens = nil
-			} else {
-				in.Delim('[')
-				if out.Tokens == nil {
-					if !in.IsDelim(']') {
-						out.Tokens = make([]SpotTokenInfo, 0, 0)
-					} else {
-						out.Tokens = []SpotTokenInfo{}
-					}
-				} else {
-					out.Tokens = (out.Tokens)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v31 SpotTokenInfo
-					(v31).UnmarshalEasyJSON(in)
-					out.Tokens = append(out.Tokens, v31)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "fill":
+			(out.Fill).UnmarshalEasyJSON(in)
+		case "twapId":
+			out.TwapID = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -2036,69 +1917,47 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid19(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid19(out *jwriter.Writer, in SpotMeta) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid19(out *jwriter.Writer, in TwapSliceFill) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"universe\":"
+		const prefix string = ",\"fill\":"
 		out.RawString(prefix[1:])
-		if in.Universe == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v32, v33 := range in.Universe {
-				if v32 > 0 {
-					out.RawByte(',')
-				}
-				(v33).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		(in.Fill).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"tokens\":"
+		const prefix string = ",\"twapId\":"
 		out.RawString(prefix)
-		if in.Tokens == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v34, v35 := range in.Tokens {
-				if v34 > 0 {
-					out.RawByte(',')
-				}
-				(v35).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.Int64(int64(in.TwapID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SpotMeta) MarshalJSON() ([]byte, error) {
+func (v TwapSliceFill) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid19(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SpotMeta) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TwapSliceFill) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid19(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SpotMeta) UnmarshalJSON(data []byte) error {
+func (v *TwapSliceFill) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid19(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SpotMeta) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TwapSliceFill) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid19(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid20(in *jlexer.Lexer, out *SpotDeployResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid20(in *jlexer.Lexer, out *TwapHistory) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2117,12 +1976,22 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid20(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
+		case "time":
+			out.Time = int64(in.Int64())
+		case "state":
+			(out.State).UnmarshalEasyJSON(in)
 		case "status":
-			out.Status = string(in.String())
-		case "txHash":
-			out.TxHash = string(in.String())
-		case "error":
-			out.Error = string(in.String())
+			(out.Status).UnmarshalEasyJSON(in)
+		case "twapId":
+			if in.IsNull() {
+				in.Skip()
+				out.TwapID = nil
+			} else {
+				if out.TwapID == nil {
+					out.TwapID = new(int64)
+				}
+				*out.TwapID = int64(in.Int64())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2133,52 +2002,57 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid20(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid20(out *jwriter.Writer, in SpotDeployResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid20(out *jwriter.Writer, in TwapHistory) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"time\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Status))
+		out.Int64(int64(in.Time))
 	}
-	if in.TxHash != "" {
-		const prefix string = ",\"txHash\":"
+	{
+		const prefix string = ",\"state\":"
 		out.RawString(prefix)
-		out.String(string(in.TxHash))
+		(in.State).MarshalEasyJSON(out)
 	}
-	if in.Error != "" {
-		const prefix string = ",\"error\":"
+	{
+		const prefix string = ",\"status\":"
 		out.RawString(prefix)
-		out.String(string(in.Error))
+		(in.Status).MarshalEasyJSON(out)
+	}
+	if in.TwapID != nil {
+		const prefix string = ",\"twapId\":"
+		out.RawString(prefix)
+		out.Int64(int64(*in.TwapID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SpotDeployResponse) MarshalJSON() ([]byte, error) {
+func (v TwapHistory) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid20(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SpotDeployResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TwapHistory) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid20(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SpotDeployResponse) UnmarshalJSON(data []byte) error {
+func (v *TwapHistory) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid20(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SpotDeployResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TwapHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid20(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid21(in *jlexer.Lexer, out *SpotBalance) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid21(in *jlexer.Lexer, out *TriggerOrderType) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2197,16 +2071,12 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid21(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "coin":
-			out.Coin = string(in.String())
-		case "token":
-			out.Token = int(in.Int())
-		case "hold":
-			out.Hold = string(in.String())
-		case "total":
-			out.Total = string(in.String())
-		case "entryNtl":
-			out.EntryNtl = string(in.String())
+		case "triggerPx":
+			out.TriggerPx = float64(in.Float64())
+		case "isMarket":
+			out.IsMarket = bool(in.Bool())
+		case "tpsl":
+			out.Tpsl = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2217,62 +2087,52 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid21(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid21(out *jwriter.Writer, in SpotBalance) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid21(out *jwriter.Writer, in TriggerOrderType) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"coin\":"
+		const prefix string = ",\"triggerPx\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Coin))
-	}
-	{
-		const prefix string = ",\"token\":"
-		out.RawString(prefix)
-		out.Int(int(in.Token))
-	}
-	{
-		const prefix string = ",\"hold\":"
-		out.RawString(prefix)
-		out.String(string(in.Hold))
+		out.Float64(float64(in.TriggerPx))
 	}
 	{
-		const prefix string = ",\"total\":"
+		const prefix string = ",\"isMarket\":"
 		out.RawString(prefix)
-		out.String(string(in.Total))
+		out.Bool(bool(in.IsMarket))
 	}
 	{
-		const prefix string = ",\"entryNtl\":"
+		const prefix string = ",\"tpsl\":"
 		out.RawString(prefix)
-		out.String(string(in.EntryNtl))
+		out.String(string(in.Tpsl))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SpotBalance) MarshalJSON() ([]byte, error) {
+func (v TriggerOrderType) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid21(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SpotBalance) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TriggerOrderType) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid21(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SpotBalance) UnmarshalJSON(data []byte) error {
+func (v *TriggerOrderType) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid21(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SpotBalance) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TriggerOrderType) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid21(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid22(in *jlexer.Lexer, out *SpotAssetInfo) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid22(in *jlexer.Lexer, out *TransferResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2291,35 +2151,12 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid22(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "tokens":
-			if in.IsNull() {
-				in.Skip()
-				out.Tokens = nil
-			} else {
-				in.Delim('[')
-				if out.Tokens == nil {
-					if !in.IsDelim(']') {
-						out.Tokens = make([]int, 0, 8)
-					} else {
-						out.Tokens = []int{}
-					}
-				} else {
-					out.Tokens = (out.Tokens)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v36 int
-					v36 = int(in.Int())
-					out.Tokens = append(out.Tokens, v36)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "index":
-			out.Index = int(in.Int())
-		case "isCanonical":
-			out.IsCanonical = bool(in.Bool())
+		case "status":
+			out.Status = string(in.String())
+		case "txHash":
+			out.TxHash = string(in.String())
+		case "error":
+			out.Error = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2330,68 +2167,52 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid22(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid22(out *jwriter.Writer, in SpotAssetInfo) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid22(out *jwriter.Writer, in TransferResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"tokens\":"
-		out.RawString(prefix)
-		if in.Tokens == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v37, v38 := range in.Tokens {
-				if v37 > 0 {
-					out.RawByte(',')
-				}
-				out.Int(int(v38))
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Status))
 	}
-	{
-		const prefix string = ",\"index\":"
+	if in.TxHash != "" {
+		const prefix string = ",\"txHash\":"
 		out.RawString(prefix)
-		out.Int(int(in.Index))
+		out.String(string(in.TxHash))
 	}
-	{
-		const prefix string = ",\"isCanonical\":"
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.IsCanonical))
+		out.String(string(in.Error))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SpotAssetInfo) MarshalJSON() ([]byte, error) {
+func (v TransferResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid22(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SpotAssetInfo) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TransferResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid22(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SpotAssetInfo) UnmarshalJSON(data []byte) error {
+func (v *TransferResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid22(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SpotAssetInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TransferResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid22(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid23(in *jlexer.Lexer, out *SpotAssetCtx) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid23(in *jlexer.Lexer, out *Tiers) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2410,26 +2231,52 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid23(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "dayNtlVlm":
-			out.DayNtlVlm = string(in.String())
-		case "markPx":
-			out.MarkPx = string(in.String())
-		case "midPx":
+		case "mm":
 			if in.IsNull() {
 				in.Skip()
-				out.MidPx = nil
+				out.MM = nil
 			} else {
-				if out.MidPx == nil {
-					out.MidPx = new(string)
+				in.Delim('[')
+				if out.MM == nil {
+					if !in.IsDelim(']') {
+						out.MM = make([]MMTier, 0, 2)
+					} else {
+						out.MM = []MMTier{}
+					}
+				} else {
+					out.MM = (out.MM)[:0]
 				}
-				*out.MidPx = string(in.String())
+				for !in.IsDelim(']') {
+					var v18 MMTier
+					(v18).UnmarshalEasyJSON(in)
+					out.MM = append(out.MM, v18)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "vip":
+			if in.IsNull() {
+				in.Skip()
+				out.VIP = nil
+			} else {
+				in.Delim('[')
+				if out.VIP == nil {
+					if !in.IsDelim(']') {
+						out.VIP = make([]VIPTier, 0, 1)
+					} else {
+						out.VIP = []VIPTier{}
+					}
+				} else {
+					out.VIP = (out.VIP)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 VIPTier
+					(v19).UnmarshalEasyJSON(in)
+					out.VIP = append(out.VIP, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "prevDayPx":
-			out.PrevDayPx = string(in.String())
-		case "circulatingSupply":
-			out.CirculatingSupply = string(in.String())
-		case "coin":
-			out.Coin = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2440,71 +2287,69 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid23(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid23(out *jwriter.Writer, in SpotAssetCtx) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid23(out *jwriter.Writer, in Tiers) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"dayNtlVlm\":"
+		const prefix string = ",\"mm\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.DayNtlVlm))
-	}
-	{
-		const prefix string = ",\"markPx\":"
-		out.RawString(prefix)
-		out.String(string(in.MarkPx))
-	}
-	{
-		const prefix string = ",\"midPx\":"
-		out.RawString(prefix)
-		if in.MidPx == nil {
+		if in.MM == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
-			out.String(string(*in.MidPx))
+			out.RawByte('[')
+			for v20, v21 := range in.MM {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				(v21).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"prevDayPx\":"
-		out.RawString(prefix)
-		out.String(string(in.PrevDayPx))
-	}
-	{
-		const prefix string = ",\"circulatingSupply\":"
-		out.RawString(prefix)
-		out.String(string(in.CirculatingSupply))
-	}
-	{
-		const prefix string = ",\"coin\":"
+		const prefix string = ",\"vip\":"
 		out.RawString(prefix)
-		out.String(string(in.Coin))
+		if in.VIP == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v22, v23 := range in.VIP {
+				if v22 > 0 {
+					out.RawByte(',')
+				}
+				(v23).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SpotAssetCtx) MarshalJSON() ([]byte, error) {
+func (v Tiers) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid23(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SpotAssetCtx) MarshalEasyJSON(w *jwriter.Writer) {
+func (v Tiers) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid23(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SpotAssetCtx) UnmarshalJSON(data []byte) error {
+func (v *Tiers) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid23(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SpotAssetCtx) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *Tiers) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid23(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid24(in *jlexer.Lexer, out *SetReferrerResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid24(in *jlexer.Lexer, out *SubAccount) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2523,10 +2368,33 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid24(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "status":
-			out.Status = string(in.String())
-		case "error":
-			out.Error = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "user":
+			out.User = string(in.String())
+		case "permissions":
+			if in.IsNull() {
+				in.Skip()
+				out.Permissions = nil
+			} else {
+				in.Delim('[')
+				if out.Permissions == nil {
+					if !in.IsDelim(']') {
+						out.Permissions = make([]string, 0, 4)
+					} else {
+						out.Permissions = []string{}
+					}
+				} else {
+					out.Permissions = (out.Permissions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v24 string
+					v24 = string(in.String())
+					out.Permissions = append(out.Permissions, v24)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2537,47 +2405,63 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid24(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid24(out *jwriter.Writer, in SetReferrerResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid24(out *jwriter.Writer, in SubAccount) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Status))
+		out.String(string(in.Name))
 	}
-	if in.Error != "" {
-		const prefix string = ",\"error\":"
+	{
+		const prefix string = ",\"user\":"
 		out.RawString(prefix)
-		out.String(string(in.Error))
+		out.String(string(in.User))
+	}
+	{
+		const prefix string = ",\"permissions\":"
+		out.RawString(prefix)
+		if in.Permissions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v25, v26 := range in.Permissions {
+				if v25 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v26))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SetReferrerResponse) MarshalJSON() ([]byte, error) {
+func (v SubAccount) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid24(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SetReferrerResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SubAccount) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid24(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SetReferrerResponse) UnmarshalJSON(data []byte) error {
+func (v *SubAccount) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid24(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SetReferrerResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SubAccount) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid24(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid25(in *jlexer.Lexer, out *ScheduleCancelResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid25(in *jlexer.Lexer, out *StakingSummary) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2596,10 +2480,14 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid25(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "status":
-			out.Status = string(in.String())
-		case "error":
-			out.Error = string(in.String())
+		case "delegated":
+			out.Delegated = string(in.String())
+		case "undelegated":
+			out.Undelegated = string(in.String())
+		case "totalPendingWithdrawal":
+			out.TotalPendingWithdrawal = string(in.String())
+		case "nPendingWithdrawals":
+			out.NPendingWithdrawals = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -2610,47 +2498,57 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid25(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid25(out *jwriter.Writer, in ScheduleCancelResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid25(out *jwriter.Writer, in StakingSummary) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"delegated\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Status))
+		out.String(string(in.Delegated))
 	}
-	if in.Error != "" {
-		const prefix string = ",\"error\":"
+	{
+		const prefix string = ",\"undelegated\":"
 		out.RawString(prefix)
-		out.String(string(in.Error))
+		out.String(string(in.Undelegated))
+	}
+	{
+		const prefix string = ",\"totalPendingWithdrawal\":"
+		out.RawString(prefix)
+		out.String(string(in.TotalPendingWithdrawal))
+	}
+	{
+		const prefix string = ",\"nPendingWithdrawals\":"
+		out.RawString(prefix)
+		out.Int(int(in.NPendingWithdrawals))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ScheduleCancelResponse) MarshalJSON() ([]byte, error) {
+func (v StakingSummary) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid25(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ScheduleCancelResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v StakingSummary) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid25(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ScheduleCancelResponse) UnmarshalJSON(data []byte) error {
+func (v *StakingSummary) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid25(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ScheduleCancelResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *StakingSummary) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid25(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid26(in *jlexer.Lexer, out *ReferralState) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid26(in *jlexer.Lexer, out *StakingReward) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2669,33 +2567,12 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid26(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "referralCode":
-			out.ReferralCode = string(in.String())
-		case "referrer":
-			out.Referrer = string(in.String())
-		case "referred":
-			if in.IsNull() {
-				in.Skip()
-				out.Referred = nil
-			} else {
-				in.Delim('[')
-				if out.Referred == nil {
-					if !in.IsDelim(']') {
-						out.Referred = make([]string, 0, 4)
-					} else {
-						out.Referred = []string{}
-					}
-				} else {
-					out.Referred = (out.Referred)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v39 string
-					v39 = string(in.String())
-					out.Referred = append(out.Referred, v39)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "time":
+			out.Time = int64(in.Int64())
+		case "source":
+			out.Source = string(in.String())
+		case "totalAmount":
+			out.TotalAmount = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2706,63 +2583,52 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid26(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid26(out *jwriter.Writer, in ReferralState) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid26(out *jwriter.Writer, in StakingReward) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"referralCode\":"
+		const prefix string = ",\"time\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ReferralCode))
+		out.Int64(int64(in.Time))
 	}
 	{
-		const prefix string = ",\"referrer\":"
+		const prefix string = ",\"source\":"
 		out.RawString(prefix)
-		out.String(string(in.Referrer))
+		out.String(string(in.Source))
 	}
 	{
-		const prefix string = ",\"referred\":"
+		const prefix string = ",\"totalAmount\":"
 		out.RawString(prefix)
-		if in.Referred == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v40, v41 := range in.Referred {
-				if v40 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v41))
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.TotalAmount))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ReferralState) MarshalJSON() ([]byte, error) {
+func (v StakingReward) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid26(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ReferralState) MarshalEasyJSON(w *jwriter.Writer) {
+func (v StakingReward) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid26(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ReferralState) UnmarshalJSON(data []byte) error {
+func (v *StakingReward) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid26(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ReferralState) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *StakingReward) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid26(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid27(in *jlexer.Lexer, out *QueriedOrder) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid27(in *jlexer.Lexer, out *StakingDelegation) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2781,44 +2647,12 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid27(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "coin":
-			out.Coin = string(in.String())
-		case "side":
-			out.Side = OrderSide(in.String())
-		case "limitPx":
-			out.LimitPx = string(in.String())
-		case "sz":
-			out.Sz = string(in.String())
-		case "oid":
-			out.Oid = int64(in.Int64())
-		case "timestamp":
-			out.Timestamp = int64(in.Int64())
-		case "triggerCondition":
-			out.TriggerCondition = string(in.String())
-		case "isTrigger":
-			out.IsTrigger = bool(in.Bool())
-		case "triggerPx":
-			out.TriggerPx = string(in.String())
-		case "isPositionTpsl":
-			out.IsPositionTpsl = bool(in.Bool())
-		case "reduceOnly":
-			out.ReduceOnly = bool(in.Bool())
-		case "orderType":
-			out.OrderType = string(in.String())
-		case "origSz":
-			out.OrigSz = string(in.String())
-		case "tif":
-			out.Tif = Tif(in.String())
-		case "cloid":
-			if in.IsNull() {
-				in.Skip()
-				out.Cloid = nil
-			} else {
-				if out.Cloid == nil {
-					out.Cloid = new(string)
-				}
-				*out.Cloid = string(in.String())
-			}
+		case "validator":
+			out.Validator = string(in.String())
+		case "amount":
+			out.Amount = string(in.String())
+		case "lockedUntilTimestamp":
+			out.LockedUntilTimestamp = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -2829,116 +2663,52 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid27(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid27(out *jwriter.Writer, in QueriedOrder) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid27(out *jwriter.Writer, in StakingDelegation) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"coin\":"
+		const prefix string = ",\"validator\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Coin))
-	}
-	{
-		const prefix string = ",\"side\":"
-		out.RawString(prefix)
-		out.String(string(in.Side))
-	}
-	{
-		const prefix string = ",\"limitPx\":"
-		out.RawString(prefix)
-		out.String(string(in.LimitPx))
-	}
-	{
-		const prefix string = ",\"sz\":"
-		out.RawString(prefix)
-		out.String(string(in.Sz))
-	}
-	{
-		const prefix string = ",\"oid\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Oid))
-	}
-	{
-		const prefix string = ",\"timestamp\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Timestamp))
-	}
-	{
-		const prefix string = ",\"triggerCondition\":"
-		out.RawString(prefix)
-		out.String(string(in.TriggerCondition))
-	}
-	{
-		const prefix string = ",\"isTrigger\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsTrigger))
-	}
-	{
-		const prefix string = ",\"triggerPx\":"
-		out.RawString(prefix)
-		out.String(string(in.TriggerPx))
-	}
-	{
-		const prefix string = ",\"isPositionTpsl\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsPositionTpsl))
-	}
-	{
-		const prefix string = ",\"reduceOnly\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.ReduceOnly))
-	}
-	{
-		const prefix string = ",\"orderType\":"
-		out.RawString(prefix)
-		out.String(string(in.OrderType))
-	}
-	{
-		const prefix string = ",\"origSz\":"
-		out.RawString(prefix)
-		out.String(string(in.OrigSz))
+		out.String(string(in.Validator))
 	}
 	{
-		const prefix string = ",\"tif\":"
+		const prefix string = ",\"amount\":"
 		out.RawString(prefix)
-		out.String(string(in.Tif))
+		out.String(string(in.Amount))
 	}
 	{
-		const prefix string = ",\"cloid\":"
+		const prefix string = ",\"lockedUntilTimestamp\":"
 		out.RawString(prefix)
-		if in.Cloid == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.Cloid))
-		}
+		out.Int64(int64(in.LockedUntilTimestamp))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v QueriedOrder) MarshalJSON() ([]byte, error) {
+func (v StakingDelegation) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid27(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v QueriedOrder) MarshalEasyJSON(w *jwriter.Writer) {
+func (v StakingDelegation) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid27(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *QueriedOrder) UnmarshalJSON(data []byte) error {
+func (v *StakingDelegation) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid27(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *QueriedOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *StakingDelegation) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid27(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid28(in *jlexer.Lexer, out *Position) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid28(in *jlexer.Lexer, out *SpotUserState) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2957,40 +2727,29 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid28(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "coin":
-			out.Coin = string(in.String())
-		case "entryPx":
+		case "balances":
 			if in.IsNull() {
 				in.Skip()
-				out.EntryPx = nil
+				out.Balances = nil
 			} else {
-				if out.EntryPx == nil {
-					out.EntryPx = new(string)
+				in.Delim('[')
+				if out.Balances == nil {
+					if !in.IsDelim(']') {
+						out.Balances = make([]SpotBalance, 0, 0)
+					} else {
+						out.Balances = []SpotBalance{}
+					}
+				} else {
+					out.Balances = (out.Balances)[:0]
 				}
-				*out.EntryPx = string(in.String())
-			}
-		case "leverage":
-			(out.Leverage).UnmarshalEasyJSON(in)
-		case "liquidationPx":
-			if in.IsNull() {
-				in.Skip()
-				out.LiquidationPx = nil
-			} else {
-				if out.LiquidationPx == nil {
-					out.LiquidationPx = new(string)
+				for !in.IsDelim(']') {
+					var v27 SpotBalance
+					(v27).UnmarshalEasyJSON(in)
+					out.Balances = append(out.Balances, v27)
+					in.WantComma()
 				}
-				*out.LiquidationPx = string(in.String())
+				in.Delim(']')
 			}
-		case "marginUsed":
-			out.MarginUsed = string(in.String())
-		case "positionValue":
-			out.PositionValue = string(in.String())
-		case "returnOnEquity":
-			out.ReturnOnEquity = string(in.String())
-		case "szi":
-			out.Szi = string(in.String())
-		case "unrealizedPnl":
-			out.UnrealizedPnl = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -3001,90 +2760,53 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid28(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid28(out *jwriter.Writer, in Position) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid28(out *jwriter.Writer, in SpotUserState) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"coin\":"
+		const prefix string = ",\"balances\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Coin))
-	}
-	{
-		const prefix string = ",\"entryPx\":"
-		out.RawString(prefix)
-		if in.EntryPx == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.EntryPx))
-		}
-	}
-	{
-		const prefix string = ",\"leverage\":"
-		out.RawString(prefix)
-		(in.Leverage).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"liquidationPx\":"
-		out.RawString(prefix)
-		if in.LiquidationPx == nil {
+		if in.Balances == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
-			out.String(string(*in.LiquidationPx))
+			out.RawByte('[')
+			for v28, v29 := range in.Balances {
+				if v28 > 0 {
+					out.RawByte(',')
+				}
+				(v29).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"marginUsed\":"
-		out.RawString(prefix)
-		out.String(string(in.MarginUsed))
-	}
-	{
-		const prefix string = ",\"positionValue\":"
-		out.RawString(prefix)
-		out.String(string(in.PositionValue))
-	}
-	{
-		const prefix string = ",\"returnOnEquity\":"
-		out.RawString(prefix)
-		out.String(string(in.ReturnOnEquity))
-	}
-	{
-		const prefix string = ",\"szi\":"
-		out.RawString(prefix)
-		out.String(string(in.Szi))
-	}
-	{
-		const prefix string = ",\"unrealizedPnl\":"
-		out.RawString(prefix)
-		out.String(string(in.UnrealizedPnl))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Position) MarshalJSON() ([]byte, error) {
+func (v SpotUserState) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid28(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Position) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SpotUserState) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid28(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Position) UnmarshalJSON(data []byte) error {
+func (v *SpotUserState) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid28(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Position) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SpotUserState) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid28(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid29(in *jlexer.Lexer, out *PerpDexSchemaInput) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid29(in *jlexer.Lexer, out *SpotTokenInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3103,19 +2825,37 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid29(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "szDecimals":
+			out.SzDecimals = int(in.Int())
+		case "weiDecimals":
+			out.WeiDecimals = int(in.Int())
+		case "index":
+			out.Index = int(in.Int())
+		case "tokenId":
+			out.TokenID = string(in.String())
+		case "isCanonical":
+			out.IsCanonical = bool(in.Bool())
+		case "evmContract":
+			if in.IsNull() {
+				in.Skip()
+				out.EvmContract = nil
+			} else {
+				if out.EvmContract == nil {
+					out.EvmContract = new(EvmContract)
+				}
+				(*out.EvmContract).UnmarshalEasyJSON(in)
+			}
 		case "fullName":
-			out.FullName = string(in.String())
-		case "collateralToken":
-			out.CollateralToken = int(in.Int())
-		case "oracleUpdater":
 			if in.IsNull() {
 				in.Skip()
-				out.OracleUpdater = nil
+				out.FullName = nil
 			} else {
-				if out.OracleUpdater == nil {
-					out.OracleUpdater = new(string)
+				if out.FullName == nil {
+					out.FullName = new(string)
 				}
-				*out.OracleUpdater = string(in.String())
+				*out.FullName = string(in.String())
 			}
 		default:
 			in.SkipRecursive()
@@ -3127,56 +2867,85 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid29(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid29(out *jwriter.Writer, in PerpDexSchemaInput) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid29(out *jwriter.Writer, in SpotTokenInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"fullName\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.FullName))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"collateralToken\":"
+		const prefix string = ",\"szDecimals\":"
 		out.RawString(prefix)
-		out.Int(int(in.CollateralToken))
+		out.Int(int(in.SzDecimals))
 	}
 	{
-		const prefix string = ",\"oracleUpdater\":"
+		const prefix string = ",\"weiDecimals\":"
 		out.RawString(prefix)
-		if in.OracleUpdater == nil {
+		out.Int(int(in.WeiDecimals))
+	}
+	{
+		const prefix string = ",\"index\":"
+		out.RawString(prefix)
+		out.Int(int(in.Index))
+	}
+	{
+		const prefix string = ",\"tokenId\":"
+		out.RawString(prefix)
+		out.String(string(in.TokenID))
+	}
+	{
+		const prefix string = ",\"isCanonical\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsCanonical))
+	}
+	{
+		const prefix string = ",\"evmContract\":"
+		out.RawString(prefix)
+		if in.EvmContract == nil {
 			out.RawString("null")
 		} else {
-			out.String(string(*in.OracleUpdater))
+			(*in.EvmContract).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"fullName\":"
+		out.RawString(prefix)
+		if in.FullName == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.FullName))
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PerpDexSchemaInput) MarshalJSON() ([]byte, error) {
+func (v SpotTokenInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid29(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PerpDexSchemaInput) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SpotTokenInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid29(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PerpDexSchemaInput) UnmarshalJSON(data []byte) error {
+func (v *SpotTokenInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid29(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PerpDexSchemaInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SpotTokenInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid29(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid30(in *jlexer.Lexer, out *PerpDeployResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid30(in *jlexer.Lexer, out *SpotMetaAndAssetCtxs) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3195,10 +2964,31 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid30(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "status":
-			out.Status = string(in.String())
-		case "data":
-			easyjson6601e8cdDecode(in, &out.Data)
+		case "Meta":
+			(out.Meta).UnmarshalEasyJSON(in)
+		case "Ctxs":
+			if in.IsNull() {
+				in.Skip()
+				out.Ctxs = nil
+			} else {
+				in.Delim('[')
+				if out.Ctxs == nil {
+					if !in.IsDelim(']') {
+						out.Ctxs = make([]SpotAssetCtx, 0, 0)
+					} else {
+						out.Ctxs = []SpotAssetCtx{}
+					}
+				} else {
+					out.Ctxs = (out.Ctxs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v30 SpotAssetCtx
+					(v30).UnmarshalEasyJSON(in)
+					out.Ctxs = append(out.Ctxs, v30)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -3209,49 +2999,58 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid30(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid30(out *jwriter.Writer, in PerpDeployResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid30(out *jwriter.Writer, in SpotMetaAndAssetCtxs) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"Meta\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Status))
+		(in.Meta).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"data\":"
+		const prefix string = ",\"Ctxs\":"
 		out.RawString(prefix)
-		easyjson6601e8cdEncode(out, in.Data)
+		if in.Ctxs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v31, v32 := range in.Ctxs {
+				if v31 > 0 {
+					out.RawByte(',')
+				}
+				(v32).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PerpDeployResponse) MarshalJSON() ([]byte, error) {
+func (v SpotMetaAndAssetCtxs) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid30(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PerpDeployResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SpotMetaAndAssetCtxs) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid30(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PerpDeployResponse) UnmarshalJSON(data []byte) error {
+func (v *SpotMetaAndAssetCtxs) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid30(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PerpDeployResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SpotMetaAndAssetCtxs) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid30(l, v)
 }
-func easyjson6601e8cdDecode(in *jlexer.Lexer, out *struct {
-	Statuses []TxStatus `json:"statuses"`
-}) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid31(in *jlexer.Lexer, out *SpotMeta) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3270,25 +3069,48 @@ func easyjson6601e8cdDecode(in *jlexer.Lexer, out *struct {
 			continue
 		}
 		switch key {
-		case "statuses":
+		case "universe":
 			if in.IsNull() {
 				in.Skip()
-				out.Statuses = nil
+				out.Universe = nil
 			} else {
 				in.Delim('[')
-				if out.Statuses == nil {
+				if out.Universe == nil {
 					if !in.IsDelim(']') {
-						out.Statuses = make([]TxStatus, 0, 2)
+						out.Universe = make([]SpotAssetInfo, 0, 1)
 					} else {
-						out.Statuses = []TxStatus{}
+						out.Universe = []SpotAssetInfo{}
 					}
 				} else {
-					out.Statuses = (out.Statuses)[:0]
+					out.Universe = (out.Universe)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v33 SpotAssetInfo
+					(v33).UnmarshalEasyJSON(in)
+					out.Universe = append(out.Universe, v33)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "tokens":
+			if in.IsNull() {
+				in.Skip()
+				out.Tokens = nil
+			} else {
+				in.Delim('[')
+				if out.Tokens == nil {
+					if !in.IsDelim(']') {
+						out.Tokens = make([]SpotTokenInfo, 0, 0)
+					} else {
+						out.Tokens = []SpotTokenInfo{}
+					}
+				} else {
+					out.Tokens = (out.Tokens)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v42 TxStatus
-					(v42).UnmarshalEasyJSON(in)
-					out.Statuses = append(out.Statuses, v42)
+					var v34 SpotTokenInfo
+					(v34).UnmarshalEasyJSON(in)
+					out.Tokens = append(out.Tokens, v34)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -3303,126 +3125,69 @@ func easyjson6601e8cdDecode(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncode(out *jwriter.Writer, in struct {
-	Statuses []TxStatus `json:"statuses"`
-}) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid31(out *jwriter.Writer, in SpotMeta) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"statuses\":"
+		const prefix string = ",\"universe\":"
 		out.RawString(prefix[1:])
-		if in.Statuses == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Universe == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v43, v44 := range in.Statuses {
-				if v43 > 0 {
+			for v35, v36 := range in.Universe {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				(v36).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"tokens\":"
+		out.RawString(prefix)
+		if in.Tokens == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v37, v38 := range in.Tokens {
+				if v37 > 0 {
 					out.RawByte(',')
 				}
-				(v44).MarshalEasyJSON(out)
+				(v38).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid31(in *jlexer.Lexer, out *OrderType) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "limit":
-			if in.IsNull() {
-				in.Skip()
-				out.Limit = nil
-			} else {
-				if out.Limit == nil {
-					out.Limit = new(LimitOrderType)
-				}
-				(*out.Limit).UnmarshalEasyJSON(in)
-			}
-		case "trigger":
-			if in.IsNull() {
-				in.Skip()
-				out.Trigger = nil
-			} else {
-				if out.Trigger == nil {
-					out.Trigger = new(TriggerOrderType)
-				}
-				(*out.Trigger).UnmarshalEasyJSON(in)
-			}
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid31(out *jwriter.Writer, in OrderType) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	if in.Limit != nil {
-		const prefix string = ",\"limit\":"
-		first = false
-		out.RawString(prefix[1:])
-		(*in.Limit).MarshalEasyJSON(out)
-	}
-	if in.Trigger != nil {
-		const prefix string = ",\"trigger\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		(*in.Trigger).MarshalEasyJSON(out)
-	}
-	out.RawByte('}')
-}
 
 // MarshalJSON supports json.Marshaler interface
-func (v OrderType) MarshalJSON() ([]byte, error) {
+func (v SpotMeta) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid31(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v OrderType) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SpotMeta) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid31(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *OrderType) UnmarshalJSON(data []byte) error {
+func (v *SpotMeta) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid31(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *OrderType) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SpotMeta) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid31(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid32(in *jlexer.Lexer, out *OrderQueryResult) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid32(in *jlexer.Lexer, out *SpotDeployResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3442,9 +3207,11 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid32(in *jlexer.Lexer, ou
 		}
 		switch key {
 		case "status":
-			out.Status = OrderQueryStatus(in.String())
-		case "order":
-			(out.Order).UnmarshalEasyJSON(in)
+			out.Status = string(in.String())
+		case "txHash":
+			out.TxHash = string(in.String())
+		case "error":
+			out.Error = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -3455,7 +3222,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid32(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid32(out *jwriter.Writer, in OrderQueryResult) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid32(out *jwriter.Writer, in SpotDeployResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3464,38 +3231,43 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid32(out *jwriter.Writer,
 		out.RawString(prefix[1:])
 		out.String(string(in.Status))
 	}
-	if true {
-		const prefix string = ",\"order\":"
+	if in.TxHash != "" {
+		const prefix string = ",\"txHash\":"
 		out.RawString(prefix)
-		(in.Order).MarshalEasyJSON(out)
+		out.String(string(in.TxHash))
+	}
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v OrderQueryResult) MarshalJSON() ([]byte, error) {
+func (v SpotDeployResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid32(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v OrderQueryResult) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SpotDeployResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid32(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *OrderQueryResult) UnmarshalJSON(data []byte) error {
+func (v *SpotDeployResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid32(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *OrderQueryResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SpotDeployResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid32(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid33(in *jlexer.Lexer, out *OrderQueryResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid33(in *jlexer.Lexer, out *SpotBalance) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3514,12 +3286,16 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid33(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "order":
-			(out.Order).UnmarshalEasyJSON(in)
-		case "status":
-			out.Status = OrderStatusValue(in.String())
-		case "statusTimestamp":
-			out.StatusTimestamp = int64(in.Int64())
+		case "coin":
+			out.Coin = string(in.String())
+		case "token":
+			out.Token = int(in.Int())
+		case "hold":
+			out.Hold = string(in.String())
+		case "total":
+			out.Total = string(in.String())
+		case "entryNtl":
+			out.EntryNtl = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -3530,52 +3306,62 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid33(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid33(out *jwriter.Writer, in OrderQueryResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid33(out *jwriter.Writer, in SpotBalance) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"order\":"
+		const prefix string = ",\"coin\":"
 		out.RawString(prefix[1:])
-		(in.Order).MarshalEasyJSON(out)
+		out.String(string(in.Coin))
 	}
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"token\":"
 		out.RawString(prefix)
-		out.String(string(in.Status))
+		out.Int(int(in.Token))
 	}
 	{
-		const prefix string = ",\"statusTimestamp\":"
+		const prefix string = ",\"hold\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.StatusTimestamp))
+		out.String(string(in.Hold))
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.String(string(in.Total))
+	}
+	{
+		const prefix string = ",\"entryNtl\":"
+		out.RawString(prefix)
+		out.String(string(in.EntryNtl))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v OrderQueryResponse) MarshalJSON() ([]byte, error) {
+func (v SpotBalance) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid33(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v OrderQueryResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SpotBalance) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid33(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *OrderQueryResponse) UnmarshalJSON(data []byte) error {
+func (v *SpotBalance) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid33(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *OrderQueryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SpotBalance) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid33(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid34(in *jlexer.Lexer, out *OpenOrder) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid34(in *jlexer.Lexer, out *SpotAssetInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3594,18 +3380,35 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid34(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "coin":
-			out.Coin = string(in.String())
-		case "limitPx":
-			out.LimitPx = float64(in.Float64Str())
-		case "oid":
-			out.Oid = int64(in.Int64())
-		case "side":
-			out.Side = string(in.String())
-		case "sz":
-			out.Size = float64(in.Float64Str())
-		case "timestamp":
-			out.Timestamp = int64(in.Int64())
+		case "name":
+			out.Name = string(in.String())
+		case "tokens":
+			if in.IsNull() {
+				in.Skip()
+				out.Tokens = nil
+			} else {
+				in.Delim('[')
+				if out.Tokens == nil {
+					if !in.IsDelim(']') {
+						out.Tokens = make([]int, 0, 8)
+					} else {
+						out.Tokens = []int{}
+					}
+				} else {
+					out.Tokens = (out.Tokens)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v39 int
+					v39 = int(in.Int())
+					out.Tokens = append(out.Tokens, v39)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "index":
+			out.Index = int(in.Int())
+		case "isCanonical":
+			out.IsCanonical = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -3616,67 +3419,68 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid34(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid34(out *jwriter.Writer, in OpenOrder) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid34(out *jwriter.Writer, in SpotAssetInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"coin\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Coin))
-	}
-	{
-		const prefix string = ",\"limitPx\":"
-		out.RawString(prefix)
-		out.Float64Str(float64(in.LimitPx))
-	}
-	{
-		const prefix string = ",\"oid\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Oid))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"side\":"
+		const prefix string = ",\"tokens\":"
 		out.RawString(prefix)
-		out.String(string(in.Side))
+		if in.Tokens == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v40, v41 := range in.Tokens {
+				if v40 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v41))
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"sz\":"
+		const prefix string = ",\"index\":"
 		out.RawString(prefix)
-		out.Float64Str(float64(in.Size))
+		out.Int(int(in.Index))
 	}
 	{
-		const prefix string = ",\"timestamp\":"
+		const prefix string = ",\"isCanonical\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Timestamp))
+		out.Bool(bool(in.IsCanonical))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v OpenOrder) MarshalJSON() ([]byte, error) {
+func (v SpotAssetInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid34(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v OpenOrder) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SpotAssetInfo) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid34(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *OpenOrder) UnmarshalJSON(data []byte) error {
+func (v *SpotAssetInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid34(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *OpenOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SpotAssetInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid34(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid35(in *jlexer.Lexer, out *MultiSigSigner) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid35(in *jlexer.Lexer, out *SpotAssetCtx) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3695,10 +3499,26 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid35(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "user":
-			out.User = string(in.String())
-		case "threshold":
-			out.Threshold = int(in.Int())
+		case "dayNtlVlm":
+			out.DayNtlVlm = string(in.String())
+		case "markPx":
+			out.MarkPx = string(in.String())
+		case "midPx":
+			if in.IsNull() {
+				in.Skip()
+				out.MidPx = nil
+			} else {
+				if out.MidPx == nil {
+					out.MidPx = new(string)
+				}
+				*out.MidPx = string(in.String())
+			}
+		case "prevDayPx":
+			out.PrevDayPx = string(in.String())
+		case "circulatingSupply":
+			out.CirculatingSupply = string(in.String())
+		case "coin":
+			out.Coin = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -3709,47 +3529,71 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid35(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid35(out *jwriter.Writer, in MultiSigSigner) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid35(out *jwriter.Writer, in SpotAssetCtx) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user\":"
+		const prefix string = ",\"dayNtlVlm\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.User))
+		out.String(string(in.DayNtlVlm))
 	}
 	{
-		const prefix string = ",\"threshold\":"
+		const prefix string = ",\"markPx\":"
 		out.RawString(prefix)
-		out.Int(int(in.Threshold))
+		out.String(string(in.MarkPx))
+	}
+	{
+		const prefix string = ",\"midPx\":"
+		out.RawString(prefix)
+		if in.MidPx == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.MidPx))
+		}
+	}
+	{
+		const prefix string = ",\"prevDayPx\":"
+		out.RawString(prefix)
+		out.String(string(in.PrevDayPx))
+	}
+	{
+		const prefix string = ",\"circulatingSupply\":"
+		out.RawString(prefix)
+		out.String(string(in.CirculatingSupply))
+	}
+	{
+		const prefix string = ",\"coin\":"
+		out.RawString(prefix)
+		out.String(string(in.Coin))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v MultiSigSigner) MarshalJSON() ([]byte, error) {
+func (v SpotAssetCtx) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid35(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v MultiSigSigner) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SpotAssetCtx) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid35(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *MultiSigSigner) UnmarshalJSON(data []byte) error {
+func (v *SpotAssetCtx) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid35(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *MultiSigSigner) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SpotAssetCtx) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid35(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid36(in *jlexer.Lexer, out *MultiSigResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid36(in *jlexer.Lexer, out *SetReferrerResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3770,8 +3614,6 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid36(in *jlexer.Lexer, ou
 		switch key {
 		case "status":
 			out.Status = string(in.String())
-		case "txHash":
-			out.TxHash = string(in.String())
 		case "error":
 			out.Error = string(in.String())
 		default:
@@ -3784,7 +3626,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid36(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid36(out *jwriter.Writer, in MultiSigResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid36(out *jwriter.Writer, in SetReferrerResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3793,11 +3635,6 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid36(out *jwriter.Writer,
 		out.RawString(prefix[1:])
 		out.String(string(in.Status))
 	}
-	if in.TxHash != "" {
-		const prefix string = ",\"txHash\":"
-		out.RawString(prefix)
-		out.String(string(in.TxHash))
-	}
 	if in.Error != "" {
 		const prefix string = ",\"error\":"
 		out.RawString(prefix)
@@ -3807,29 +3644,29 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid36(out *jwriter.Writer,
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v MultiSigResponse) MarshalJSON() ([]byte, error) {
+func (v SetReferrerResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid36(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v MultiSigResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SetReferrerResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid36(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *MultiSigResponse) UnmarshalJSON(data []byte) error {
+func (v *SetReferrerResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid36(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *MultiSigResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SetReferrerResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid36(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid37(in *jlexer.Lexer, out *MultiSigConversionResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid37(in *jlexer.Lexer, out *ScheduleCancelResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3850,8 +3687,6 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid37(in *jlexer.Lexer, ou
 		switch key {
 		case "status":
 			out.Status = string(in.String())
-		case "txHash":
-			out.TxHash = string(in.String())
 		case "error":
 			out.Error = string(in.String())
 		default:
@@ -3864,7 +3699,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid37(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid37(out *jwriter.Writer, in MultiSigConversionResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid37(out *jwriter.Writer, in ScheduleCancelResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3873,11 +3708,6 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid37(out *jwriter.Writer,
 		out.RawString(prefix[1:])
 		out.String(string(in.Status))
 	}
-	if in.TxHash != "" {
-		const prefix string = ",\"txHash\":"
-		out.RawString(prefix)
-		out.String(string(in.TxHash))
-	}
 	if in.Error != "" {
 		const prefix string = ",\"error\":"
 		out.RawString(prefix)
@@ -3887,29 +3717,29 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid37(out *jwriter.Writer,
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v MultiSigConversionResponse) MarshalJSON() ([]byte, error) {
+func (v ScheduleCancelResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid37(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v MultiSigConversionResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v ScheduleCancelResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid37(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *MultiSigConversionResponse) UnmarshalJSON(data []byte) error {
+func (v *ScheduleCancelResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid37(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *MultiSigConversionResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *ScheduleCancelResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid37(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid38(in *jlexer.Lexer, out *ModifyResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid38(in *jlexer.Lexer, out *ReferralState) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3928,33 +3758,33 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid38(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "status":
-			out.Status = string(in.String())
-		case "data":
+		case "referralCode":
+			out.ReferralCode = string(in.String())
+		case "referrer":
+			out.Referrer = string(in.String())
+		case "referred":
 			if in.IsNull() {
 				in.Skip()
-				out.Data = nil
+				out.Referred = nil
 			} else {
 				in.Delim('[')
-				if out.Data == nil {
+				if out.Referred == nil {
 					if !in.IsDelim(']') {
-						out.Data = make([]OrderStatus, 0, 2)
+						out.Referred = make([]string, 0, 4)
 					} else {
-						out.Data = []OrderStatus{}
+						out.Referred = []string{}
 					}
 				} else {
-					out.Data = (out.Data)[:0]
+					out.Referred = (out.Referred)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v45 OrderStatus
-					easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid39(in, &v45)
-					out.Data = append(out.Data, v45)
+					var v42 string
+					v42 = string(in.String())
+					out.Referred = append(out.Referred, v42)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "error":
-			out.Error = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -3965,61 +3795,63 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid38(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid38(out *jwriter.Writer, in ModifyResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid38(out *jwriter.Writer, in ReferralState) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"referralCode\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Status))
+		out.String(string(in.ReferralCode))
 	}
-	if len(in.Data) != 0 {
-		const prefix string = ",\"data\":"
+	{
+		const prefix string = ",\"referrer\":"
 		out.RawString(prefix)
-		{
+		out.String(string(in.Referrer))
+	}
+	{
+		const prefix string = ",\"referred\":"
+		out.RawString(prefix)
+		if in.Referred == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
 			out.RawByte('[')
-			for v46, v47 := range in.Data {
-				if v46 > 0 {
+			for v43, v44 := range in.Referred {
+				if v43 > 0 {
 					out.RawByte(',')
 				}
-				easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid39(out, v47)
+				out.String(string(v44))
 			}
 			out.RawByte(']')
 		}
 	}
-	if in.Error != "" {
-		const prefix string = ",\"error\":"
-		out.RawString(prefix)
-		out.String(string(in.Error))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ModifyResponse) MarshalJSON() ([]byte, error) {
+func (v ReferralState) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid38(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ModifyResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v ReferralState) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid38(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ModifyResponse) UnmarshalJSON(data []byte) error {
+func (v *ReferralState) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid38(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ModifyResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *ReferralState) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid38(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid39(in *jlexer.Lexer, out *OrderStatus) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid39(in *jlexer.Lexer, out *QueriedOrder) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4038,35 +3870,66 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid39(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "resting":
+		case "coin":
+			out.Coin = string(in.String())
+		case "side":
+			out.Side = OrderSide(in.String())
+		case "limitPx":
+			out.LimitPx = string(in.String())
+		case "sz":
+			out.Sz = string(in.String())
+		case "oid":
+			out.Oid = int64(in.Int64())
+		case "timestamp":
+			out.Timestamp = int64(in.Int64())
+		case "triggerCondition":
+			out.TriggerCondition = string(in.String())
+		case "isTrigger":
+			out.IsTrigger = bool(in.Bool())
+		case "triggerPx":
+			out.TriggerPx = string(in.String())
+		case "isPositionTpsl":
+			out.IsPositionTpsl = bool(in.Bool())
+		case "reduceOnly":
+			out.ReduceOnly = bool(in.Bool())
+		case "orderType":
+			out.OrderType = string(in.String())
+		case "origSz":
+			out.OrigSz = string(in.String())
+		case "tif":
+			out.Tif = Tif(in.String())
+		case "cloid":
 			if in.IsNull() {
 				in.Skip()
-				out.Resting = nil
+				out.Cloid = nil
 			} else {
-				if out.Resting == nil {
-					out.Resting = new(OrderStatusResting)
+				if out.Cloid == nil {
+					out.Cloid = new(string)
 				}
-				easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid40(in, out.Resting)
+				*out.Cloid = string(in.String())
 			}
-		case "filled":
+		case "children":
 			if in.IsNull() {
 				in.Skip()
-				out.Filled = nil
+				out.Children = nil
 			} else {
-				if out.Filled == nil {
-					out.Filled = new(OrderStatusFilled)
+				in.Delim('[')
+				if out.Children == nil {
+					if !in.IsDelim(']') {
+						out.Children = make([]QueriedOrder, 0, 0)
+					} else {
+						out.Children = []QueriedOrder{}
+					}
+				} else {
+					out.Children = (out.Children)[:0]
 				}
-				easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid41(in, out.Filled)
-			}
-		case "error":
-			if in.IsNull() {
-				in.Skip()
-				out.Error = nil
-			} else {
-				if out.Error == nil {
-					out.Error = new(string)
+				for !in.IsDelim(']') {
+					var v45 QueriedOrder
+					(v45).UnmarshalEasyJSON(in)
+					out.Children = append(out.Children, v45)
+					in.WantComma()
 				}
-				*out.Error = string(in.String())
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -4078,163 +3941,130 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid39(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid39(out *jwriter.Writer, in OrderStatus) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid39(out *jwriter.Writer, in QueriedOrder) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.Resting != nil {
-		const prefix string = ",\"resting\":"
-		first = false
+	{
+		const prefix string = ",\"coin\":"
 		out.RawString(prefix[1:])
-		easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid40(out, *in.Resting)
+		out.String(string(in.Coin))
 	}
-	if in.Filled != nil {
-		const prefix string = ",\"filled\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid41(out, *in.Filled)
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
 	}
-	if in.Error != nil {
-		const prefix string = ",\"error\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(*in.Error))
+	{
+		const prefix string = ",\"limitPx\":"
+		out.RawString(prefix)
+		out.String(string(in.LimitPx))
 	}
-	out.RawByte('}')
-}
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid41(in *jlexer.Lexer, out *OrderStatusFilled) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
+	{
+		const prefix string = ",\"sz\":"
+		out.RawString(prefix)
+		out.String(string(in.Sz))
 	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "totalSz":
-			out.TotalSz = string(in.String())
-		case "avgPx":
-			out.AvgPx = string(in.String())
-		case "oid":
-			out.Oid = int(in.Int())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
+	{
+		const prefix string = ",\"oid\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Oid))
 	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
+	{
+		const prefix string = ",\"timestamp\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Timestamp))
 	}
-}
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid41(out *jwriter.Writer, in OrderStatusFilled) {
-	out.RawByte('{')
-	first := true
-	_ = first
 	{
-		const prefix string = ",\"totalSz\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.TotalSz))
+		const prefix string = ",\"triggerCondition\":"
+		out.RawString(prefix)
+		out.String(string(in.TriggerCondition))
 	}
 	{
-		const prefix string = ",\"avgPx\":"
+		const prefix string = ",\"isTrigger\":"
 		out.RawString(prefix)
-		out.String(string(in.AvgPx))
+		out.Bool(bool(in.IsTrigger))
 	}
 	{
-		const prefix string = ",\"oid\":"
+		const prefix string = ",\"triggerPx\":"
 		out.RawString(prefix)
-		out.Int(int(in.Oid))
+		out.String(string(in.TriggerPx))
 	}
-	out.RawByte('}')
-}
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid40(in *jlexer.Lexer, out *OrderStatusResting) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
+	{
+		const prefix string = ",\"isPositionTpsl\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPositionTpsl))
 	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "oid":
-			out.Oid = int64(in.Int64())
-		case "cloid":
-			if in.IsNull() {
-				in.Skip()
-				out.ClientID = nil
-			} else {
-				if out.ClientID == nil {
-					out.ClientID = new(string)
-				}
-				*out.ClientID = string(in.String())
-			}
-		case "status":
-			out.Status = string(in.String())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
+	{
+		const prefix string = ",\"reduceOnly\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReduceOnly))
 	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
+	{
+		const prefix string = ",\"orderType\":"
+		out.RawString(prefix)
+		out.String(string(in.OrderType))
 	}
-}
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid40(out *jwriter.Writer, in OrderStatusResting) {
-	out.RawByte('{')
-	first := true
-	_ = first
 	{
-		const prefix string = ",\"oid\":"
-		out.RawString(prefix[1:])
-		out.Int64(int64(in.Oid))
+		const prefix string = ",\"origSz\":"
+		out.RawString(prefix)
+		out.String(string(in.OrigSz))
+	}
+	{
+		const prefix string = ",\"tif\":"
+		out.RawString(prefix)
+		out.String(string(in.Tif))
 	}
 	{
 		const prefix string = ",\"cloid\":"
 		out.RawString(prefix)
-		if in.ClientID == nil {
+		if in.Cloid == nil {
 			out.RawString("null")
 		} else {
-			out.String(string(*in.ClientID))
+			out.String(string(*in.Cloid))
 		}
 	}
-	{
-		const prefix string = ",\"status\":"
+	if len(in.Children) != 0 {
+		const prefix string = ",\"children\":"
 		out.RawString(prefix)
-		out.String(string(in.Status))
+		{
+			out.RawByte('[')
+			for v46, v47 := range in.Children {
+				if v46 > 0 {
+					out.RawByte(',')
+				}
+				(v47).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid42(in *jlexer.Lexer, out *MetaAndAssetCtxs) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueriedOrder) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid39(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueriedOrder) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid39(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueriedOrder) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid39(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueriedOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid39(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid40(in *jlexer.Lexer, out *Position) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4253,75 +4083,40 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid42(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "Ctxs":
-			if in.IsNull() {
-				in.Skip()
-				out.Ctxs = nil
-			} else {
-				in.Delim('[')
-				if out.Ctxs == nil {
-					if !in.IsDelim(']') {
-						out.Ctxs = make([]AssetCtx, 0, 0)
-					} else {
-						out.Ctxs = []AssetCtx{}
-					}
-				} else {
-					out.Ctxs = (out.Ctxs)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v48 AssetCtx
-					(v48).UnmarshalEasyJSON(in)
-					out.Ctxs = append(out.Ctxs, v48)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "universe":
+		case "coin":
+			out.Coin = string(in.String())
+		case "entryPx":
 			if in.IsNull() {
 				in.Skip()
-				out.Universe = nil
+				out.EntryPx = nil
 			} else {
-				in.Delim('[')
-				if out.Universe == nil {
-					if !in.IsDelim(']') {
-						out.Universe = make([]AssetInfo, 0, 2)
-					} else {
-						out.Universe = []AssetInfo{}
-					}
-				} else {
-					out.Universe = (out.Universe)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v49 AssetInfo
-					(v49).UnmarshalEasyJSON(in)
-					out.Universe = append(out.Universe, v49)
-					in.WantComma()
+				if out.EntryPx == nil {
+					out.EntryPx = new(string)
 				}
-				in.Delim(']')
+				*out.EntryPx = string(in.String())
 			}
-		case "marginTables":
+		case "leverage":
+			(out.Leverage).UnmarshalEasyJSON(in)
+		case "liquidationPx":
 			if in.IsNull() {
 				in.Skip()
-				out.MarginTables = nil
+				out.LiquidationPx = nil
 			} else {
-				in.Delim('[')
-				if out.MarginTables == nil {
-					if !in.IsDelim(']') {
-						out.MarginTables = make([]MarginTable, 0, 1)
-					} else {
-						out.MarginTables = []MarginTable{}
-					}
-				} else {
-					out.MarginTables = (out.MarginTables)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v50 MarginTable
-					(v50).UnmarshalEasyJSON(in)
-					out.MarginTables = append(out.MarginTables, v50)
-					in.WantComma()
+				if out.LiquidationPx == nil {
+					out.LiquidationPx = new(string)
 				}
-				in.Delim(']')
+				*out.LiquidationPx = string(in.String())
 			}
+		case "marginUsed":
+			out.MarginUsed = string(in.String())
+		case "positionValue":
+			out.PositionValue = string(in.String())
+		case "returnOnEquity":
+			out.ReturnOnEquity = string(in.String())
+		case "szi":
+			out.Szi = string(in.String())
+		case "unrealizedPnl":
+			out.UnrealizedPnl = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -4332,85 +4127,90 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid42(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid42(out *jwriter.Writer, in MetaAndAssetCtxs) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid40(out *jwriter.Writer, in Position) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Ctxs\":"
+		const prefix string = ",\"coin\":"
 		out.RawString(prefix[1:])
-		if in.Ctxs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v51, v52 := range in.Ctxs {
-				if v51 > 0 {
-					out.RawByte(',')
-				}
-				(v52).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Coin))
 	}
 	{
-		const prefix string = ",\"universe\":"
+		const prefix string = ",\"entryPx\":"
 		out.RawString(prefix)
-		if in.Universe == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.EntryPx == nil {
 			out.RawString("null")
 		} else {
-			out.RawByte('[')
-			for v53, v54 := range in.Universe {
-				if v53 > 0 {
-					out.RawByte(',')
-				}
-				(v54).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
+			out.String(string(*in.EntryPx))
 		}
 	}
 	{
-		const prefix string = ",\"marginTables\":"
+		const prefix string = ",\"leverage\":"
 		out.RawString(prefix)
-		if in.MarginTables == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		(in.Leverage).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"liquidationPx\":"
+		out.RawString(prefix)
+		if in.LiquidationPx == nil {
 			out.RawString("null")
 		} else {
-			out.RawByte('[')
-			for v55, v56 := range in.MarginTables {
-				if v55 > 0 {
-					out.RawByte(',')
-				}
-				(v56).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
+			out.String(string(*in.LiquidationPx))
 		}
 	}
+	{
+		const prefix string = ",\"marginUsed\":"
+		out.RawString(prefix)
+		out.String(string(in.MarginUsed))
+	}
+	{
+		const prefix string = ",\"positionValue\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionValue))
+	}
+	{
+		const prefix string = ",\"returnOnEquity\":"
+		out.RawString(prefix)
+		out.String(string(in.ReturnOnEquity))
+	}
+	{
+		const prefix string = ",\"szi\":"
+		out.RawString(prefix)
+		out.String(string(in.Szi))
+	}
+	{
+		const prefix string = ",\"unrealizedPnl\":"
+		out.RawString(prefix)
+		out.String(string(in.UnrealizedPnl))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v MetaAndAssetCtxs) MarshalJSON() ([]byte, error) {
+func (v Position) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid42(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid40(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v MetaAndAssetCtxs) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid42(w, v)
+func (v Position) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid40(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *MetaAndAssetCtxs) UnmarshalJSON(data []byte) error {
+func (v *Position) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid42(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid40(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *MetaAndAssetCtxs) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid42(l, v)
+func (v *Position) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid40(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid43(in *jlexer.Lexer, out *Meta) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid41(in *jlexer.Lexer, out *PerpDexSchemaInput) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4429,48 +4229,2716 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid43(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "universe":
+		case "fullName":
+			out.FullName = string(in.String())
+		case "collateralToken":
+			out.CollateralToken = int(in.Int())
+		case "oracleUpdater":
 			if in.IsNull() {
 				in.Skip()
-				out.Universe = nil
+				out.OracleUpdater = nil
 			} else {
-				in.Delim('[')
-				if out.Universe == nil {
-					if !in.IsDelim(']') {
-						out.Universe = make([]AssetInfo, 0, 2)
-					} else {
-						out.Universe = []AssetInfo{}
-					}
-				} else {
-					out.Universe = (out.Universe)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v57 AssetInfo
-					(v57).UnmarshalEasyJSON(in)
-					out.Universe = append(out.Universe, v57)
-					in.WantComma()
+				if out.OracleUpdater == nil {
+					out.OracleUpdater = new(string)
 				}
-				in.Delim(']')
+				*out.OracleUpdater = string(in.String())
 			}
-		case "marginTables":
-			if in.IsNull() {
-				in.Skip()
-				out.MarginTables = nil
-			} else {
-				in.Delim('[')
-				if out.MarginTables == nil {
-					if !in.IsDelim(']') {
-						out.MarginTables = make([]MarginTable, 0, 1)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid41(out *jwriter.Writer, in PerpDexSchemaInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"fullName\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.FullName))
+	}
+	{
+		const prefix string = ",\"collateralToken\":"
+		out.RawString(prefix)
+		out.Int(int(in.CollateralToken))
+	}
+	{
+		const prefix string = ",\"oracleUpdater\":"
+		out.RawString(prefix)
+		if in.OracleUpdater == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.OracleUpdater))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PerpDexSchemaInput) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid41(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PerpDexSchemaInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid41(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PerpDexSchemaInput) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid41(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PerpDexSchemaInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid41(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid42(in *jlexer.Lexer, out *PerpDeployResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = string(in.String())
+		case "data":
+			easyjson6601e8cdDecode(in, &out.Data)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid42(out *jwriter.Writer, in PerpDeployResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"data\":"
+		out.RawString(prefix)
+		easyjson6601e8cdEncode(out, in.Data)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PerpDeployResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid42(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PerpDeployResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid42(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PerpDeployResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid42(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PerpDeployResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid42(l, v)
+}
+func easyjson6601e8cdDecode(in *jlexer.Lexer, out *struct {
+	Statuses []TxStatus `json:"statuses"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "statuses":
+			if in.IsNull() {
+				in.Skip()
+				out.Statuses = nil
+			} else {
+				in.Delim('[')
+				if out.Statuses == nil {
+					if !in.IsDelim(']') {
+						out.Statuses = make([]TxStatus, 0, 2)
+					} else {
+						out.Statuses = []TxStatus{}
+					}
+				} else {
+					out.Statuses = (out.Statuses)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v48 TxStatus
+					(v48).UnmarshalEasyJSON(in)
+					out.Statuses = append(out.Statuses, v48)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncode(out *jwriter.Writer, in struct {
+	Statuses []TxStatus `json:"statuses"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"statuses\":"
+		out.RawString(prefix[1:])
+		if in.Statuses == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v49, v50 := range in.Statuses {
+				if v49 > 0 {
+					out.RawByte(',')
+				}
+				(v50).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid43(in *jlexer.Lexer, out *OrderType) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "limit":
+			if in.IsNull() {
+				in.Skip()
+				out.Limit = nil
+			} else {
+				if out.Limit == nil {
+					out.Limit = new(LimitOrderType)
+				}
+				(*out.Limit).UnmarshalEasyJSON(in)
+			}
+		case "trigger":
+			if in.IsNull() {
+				in.Skip()
+				out.Trigger = nil
+			} else {
+				if out.Trigger == nil {
+					out.Trigger = new(TriggerOrderType)
+				}
+				(*out.Trigger).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid43(out *jwriter.Writer, in OrderType) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Limit != nil {
+		const prefix string = ",\"limit\":"
+		first = false
+		out.RawString(prefix[1:])
+		(*in.Limit).MarshalEasyJSON(out)
+	}
+	if in.Trigger != nil {
+		const prefix string = ",\"trigger\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Trigger).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OrderType) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid43(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OrderType) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid43(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OrderType) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid43(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OrderType) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid43(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid44(in *jlexer.Lexer, out *OrderQueryResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = OrderQueryStatus(in.String())
+		case "order":
+			(out.Order).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid44(out *jwriter.Writer, in OrderQueryResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	if true {
+		const prefix string = ",\"order\":"
+		out.RawString(prefix)
+		(in.Order).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OrderQueryResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid44(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OrderQueryResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid44(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OrderQueryResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid44(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OrderQueryResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid44(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid45(in *jlexer.Lexer, out *OrderQueryResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "order":
+			(out.Order).UnmarshalEasyJSON(in)
+		case "status":
+			out.Status = OrderStatusValue(in.String())
+		case "statusTimestamp":
+			out.StatusTimestamp = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid45(out *jwriter.Writer, in OrderQueryResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"order\":"
+		out.RawString(prefix[1:])
+		(in.Order).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"statusTimestamp\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.StatusTimestamp))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OrderQueryResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid45(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OrderQueryResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid45(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OrderQueryResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid45(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OrderQueryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid45(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid46(in *jlexer.Lexer, out *OpenOrder) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "coin":
+			out.Coin = string(in.String())
+		case "limitPx":
+			out.LimitPx = float64(in.Float64Str())
+		case "oid":
+			out.Oid = int64(in.Int64())
+		case "side":
+			out.Side = string(in.String())
+		case "sz":
+			out.Size = float64(in.Float64Str())
+		case "timestamp":
+			out.Timestamp = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid46(out *jwriter.Writer, in OpenOrder) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"coin\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Coin))
+	}
+	{
+		const prefix string = ",\"limitPx\":"
+		out.RawString(prefix)
+		out.Float64Str(float64(in.LimitPx))
+	}
+	{
+		const prefix string = ",\"oid\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Oid))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.String(string(in.Side))
+	}
+	{
+		const prefix string = ",\"sz\":"
+		out.RawString(prefix)
+		out.Float64Str(float64(in.Size))
+	}
+	{
+		const prefix string = ",\"timestamp\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Timestamp))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OpenOrder) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid46(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OpenOrder) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid46(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OpenOrder) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid46(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OpenOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid46(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid47(in *jlexer.Lexer, out *MultiSigSigners) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "authorizedUsers":
+			if in.IsNull() {
+				in.Skip()
+				out.AuthorizedUsers = nil
+			} else {
+				in.Delim('[')
+				if out.AuthorizedUsers == nil {
+					if !in.IsDelim(']') {
+						out.AuthorizedUsers = make([]string, 0, 4)
+					} else {
+						out.AuthorizedUsers = []string{}
+					}
+				} else {
+					out.AuthorizedUsers = (out.AuthorizedUsers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v51 string
+					v51 = string(in.String())
+					out.AuthorizedUsers = append(out.AuthorizedUsers, v51)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "threshold":
+			out.Threshold = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid47(out *jwriter.Writer, in MultiSigSigners) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"authorizedUsers\":"
+		out.RawString(prefix[1:])
+		if in.AuthorizedUsers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v52, v53 := range in.AuthorizedUsers {
+				if v52 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v53))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"threshold\":"
+		out.RawString(prefix)
+		out.Int(int(in.Threshold))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MultiSigSigners) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid47(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MultiSigSigners) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid47(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MultiSigSigners) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid47(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MultiSigSigners) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid47(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid48(in *jlexer.Lexer, out *MultiSigResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = string(in.String())
+		case "txHash":
+			out.TxHash = string(in.String())
+		case "error":
+			out.Error = string(in.String())
+		case "response":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Response).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid48(out *jwriter.Writer, in MultiSigResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	if in.TxHash != "" {
+		const prefix string = ",\"txHash\":"
+		out.RawString(prefix)
+		out.String(string(in.TxHash))
+	}
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	if len(in.Response) != 0 {
+		const prefix string = ",\"response\":"
+		out.RawString(prefix)
+		out.Raw((in.Response).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MultiSigResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid48(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MultiSigResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid48(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MultiSigResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid48(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MultiSigResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid48(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid49(in *jlexer.Lexer, out *MultiSigConversionResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = string(in.String())
+		case "txHash":
+			out.TxHash = string(in.String())
+		case "error":
+			out.Error = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid49(out *jwriter.Writer, in MultiSigConversionResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	if in.TxHash != "" {
+		const prefix string = ",\"txHash\":"
+		out.RawString(prefix)
+		out.String(string(in.TxHash))
+	}
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MultiSigConversionResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid49(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MultiSigConversionResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid49(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MultiSigConversionResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid49(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MultiSigConversionResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid49(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid50(in *jlexer.Lexer, out *ModifyResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = string(in.String())
+		case "data":
+			if in.IsNull() {
+				in.Skip()
+				out.Data = nil
+			} else {
+				in.Delim('[')
+				if out.Data == nil {
+					if !in.IsDelim(']') {
+						out.Data = make([]OrderStatus, 0, 1)
+					} else {
+						out.Data = []OrderStatus{}
+					}
+				} else {
+					out.Data = (out.Data)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v54 OrderStatus
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v54).UnmarshalJSON(data))
+					}
+					out.Data = append(out.Data, v54)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "error":
+			out.Error = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid50(out *jwriter.Writer, in ModifyResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	if len(in.Data) != 0 {
+		const prefix string = ",\"data\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v55, v56 := range in.Data {
+				if v55 > 0 {
+					out.RawByte(',')
+				}
+				out.Raw((v56).MarshalJSON())
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModifyResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid50(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModifyResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid50(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModifyResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid50(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModifyResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid50(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid51(in *jlexer.Lexer, out *MetaAndAssetCtxs) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Ctxs":
+			if in.IsNull() {
+				in.Skip()
+				out.Ctxs = nil
+			} else {
+				in.Delim('[')
+				if out.Ctxs == nil {
+					if !in.IsDelim(']') {
+						out.Ctxs = make([]AssetCtx, 0, 0)
+					} else {
+						out.Ctxs = []AssetCtx{}
+					}
+				} else {
+					out.Ctxs = (out.Ctxs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v57 AssetCtx
+					(v57).UnmarshalEasyJSON(in)
+					out.Ctxs = append(out.Ctxs, v57)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "universe":
+			if in.IsNull() {
+				in.Skip()
+				out.Universe = nil
+			} else {
+				in.Delim('[')
+				if out.Universe == nil {
+					if !in.IsDelim(']') {
+						out.Universe = make([]AssetInfo, 0, 1)
+					} else {
+						out.Universe = []AssetInfo{}
+					}
+				} else {
+					out.Universe = (out.Universe)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v58 AssetInfo
+					(v58).UnmarshalEasyJSON(in)
+					out.Universe = append(out.Universe, v58)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "marginTables":
+			if in.IsNull() {
+				in.Skip()
+				out.MarginTables = nil
+			} else {
+				in.Delim('[')
+				if out.MarginTables == nil {
+					if !in.IsDelim(']') {
+						out.MarginTables = make([]MarginTable, 0, 1)
+					} else {
+						out.MarginTables = []MarginTable{}
+					}
+				} else {
+					out.MarginTables = (out.MarginTables)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v59 MarginTable
+					(v59).UnmarshalEasyJSON(in)
+					out.MarginTables = append(out.MarginTables, v59)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid51(out *jwriter.Writer, in MetaAndAssetCtxs) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Ctxs\":"
+		out.RawString(prefix[1:])
+		if in.Ctxs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v60, v61 := range in.Ctxs {
+				if v60 > 0 {
+					out.RawByte(',')
+				}
+				(v61).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"universe\":"
+		out.RawString(prefix)
+		if in.Universe == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v62, v63 := range in.Universe {
+				if v62 > 0 {
+					out.RawByte(',')
+				}
+				(v63).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"marginTables\":"
+		out.RawString(prefix)
+		if in.MarginTables == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v64, v65 := range in.MarginTables {
+				if v64 > 0 {
+					out.RawByte(',')
+				}
+				(v65).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MetaAndAssetCtxs) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid51(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MetaAndAssetCtxs) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid51(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MetaAndAssetCtxs) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid51(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MetaAndAssetCtxs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid51(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid52(in *jlexer.Lexer, out *Meta) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "universe":
+			if in.IsNull() {
+				in.Skip()
+				out.Universe = nil
+			} else {
+				in.Delim('[')
+				if out.Universe == nil {
+					if !in.IsDelim(']') {
+						out.Universe = make([]AssetInfo, 0, 1)
+					} else {
+						out.Universe = []AssetInfo{}
+					}
+				} else {
+					out.Universe = (out.Universe)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v66 AssetInfo
+					(v66).UnmarshalEasyJSON(in)
+					out.Universe = append(out.Universe, v66)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "marginTables":
+			if in.IsNull() {
+				in.Skip()
+				out.MarginTables = nil
+			} else {
+				in.Delim('[')
+				if out.MarginTables == nil {
+					if !in.IsDelim(']') {
+						out.MarginTables = make([]MarginTable, 0, 1)
+					} else {
+						out.MarginTables = []MarginTable{}
+					}
+				} else {
+					out.MarginTables = (out.MarginTables)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v67 MarginTable
+					(v67).UnmarshalEasyJSON(in)
+					out.MarginTables = append(out.MarginTables, v67)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid52(out *jwriter.Writer, in Meta) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"universe\":"
+		out.RawString(prefix[1:])
+		if in.Universe == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v68, v69 := range in.Universe {
+				if v68 > 0 {
+					out.RawByte(',')
+				}
+				(v69).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"marginTables\":"
+		out.RawString(prefix)
+		if in.MarginTables == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v70, v71 := range in.MarginTables {
+				if v70 > 0 {
+					out.RawByte(',')
+				}
+				(v71).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Meta) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid52(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Meta) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid52(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Meta) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid52(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Meta) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid52(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid53(in *jlexer.Lexer, out *MarginTier) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "lowerBound":
+			out.LowerBound = string(in.String())
+		case "maxLeverage":
+			out.MaxLeverage = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid53(out *jwriter.Writer, in MarginTier) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"lowerBound\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.LowerBound))
+	}
+	{
+		const prefix string = ",\"maxLeverage\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxLeverage))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MarginTier) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid53(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MarginTier) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid53(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MarginTier) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid53(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MarginTier) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid53(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid54(in *jlexer.Lexer, out *MarginTable) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ID":
+			out.ID = int(in.Int())
+		case "description":
+			out.Description = string(in.String())
+		case "marginTiers":
+			if in.IsNull() {
+				in.Skip()
+				out.MarginTiers = nil
+			} else {
+				in.Delim('[')
+				if out.MarginTiers == nil {
+					if !in.IsDelim(']') {
+						out.MarginTiers = make([]MarginTier, 0, 2)
+					} else {
+						out.MarginTiers = []MarginTier{}
+					}
+				} else {
+					out.MarginTiers = (out.MarginTiers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v72 MarginTier
+					(v72).UnmarshalEasyJSON(in)
+					out.MarginTiers = append(out.MarginTiers, v72)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid54(out *jwriter.Writer, in MarginTable) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"marginTiers\":"
+		out.RawString(prefix)
+		if in.MarginTiers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v73, v74 := range in.MarginTiers {
+				if v73 > 0 {
+					out.RawByte(',')
+				}
+				(v74).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MarginTable) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid54(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MarginTable) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid54(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MarginTable) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid54(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MarginTable) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid54(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid55(in *jlexer.Lexer, out *MarginSummary) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "accountValue":
+			out.AccountValue = string(in.String())
+		case "totalMarginUsed":
+			out.TotalMarginUsed = string(in.String())
+		case "totalNtlPos":
+			out.TotalNtlPos = string(in.String())
+		case "totalRawUsd":
+			out.TotalRawUsd = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid55(out *jwriter.Writer, in MarginSummary) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"accountValue\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AccountValue))
+	}
+	{
+		const prefix string = ",\"totalMarginUsed\":"
+		out.RawString(prefix)
+		out.String(string(in.TotalMarginUsed))
+	}
+	{
+		const prefix string = ",\"totalNtlPos\":"
+		out.RawString(prefix)
+		out.String(string(in.TotalNtlPos))
+	}
+	{
+		const prefix string = ",\"totalRawUsd\":"
+		out.RawString(prefix)
+		out.String(string(in.TotalRawUsd))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MarginSummary) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid55(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MarginSummary) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid55(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MarginSummary) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid55(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MarginSummary) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid55(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid56(in *jlexer.Lexer, out *MMTier) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "add":
+			out.Add = string(in.String())
+		case "makerFractionCutoff":
+			out.MakerFractionCutoff = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid56(out *jwriter.Writer, in MMTier) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"add\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Add))
+	}
+	{
+		const prefix string = ",\"makerFractionCutoff\":"
+		out.RawString(prefix)
+		out.String(string(in.MakerFractionCutoff))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MMTier) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid56(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MMTier) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid56(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MMTier) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid56(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MMTier) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid56(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid57(in *jlexer.Lexer, out *LimitOrderType) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "tif":
+			out.Tif = Tif(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid57(out *jwriter.Writer, in LimitOrderType) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"tif\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Tif))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LimitOrderType) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid57(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LimitOrderType) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid57(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LimitOrderType) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid57(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LimitOrderType) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid57(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid58(in *jlexer.Lexer, out *Leverage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "value":
+			out.Value = int(in.Int())
+		case "rawUsd":
+			if in.IsNull() {
+				in.Skip()
+				out.RawUsd = nil
+			} else {
+				if out.RawUsd == nil {
+					out.RawUsd = new(string)
+				}
+				*out.RawUsd = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid58(out *jwriter.Writer, in Leverage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix)
+		out.Int(int(in.Value))
+	}
+	if in.RawUsd != nil {
+		const prefix string = ",\"rawUsd\":"
+		out.RawString(prefix)
+		out.String(string(*in.RawUsd))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Leverage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid58(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Leverage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid58(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Leverage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid58(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Leverage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid58(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid59(in *jlexer.Lexer, out *LedgerWithdraw) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "usdc":
+			out.Usdc = string(in.String())
+		case "nonce":
+			out.Nonce = int64(in.Int64())
+		case "fee":
+			out.Fee = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid59(out *jwriter.Writer, in LedgerWithdraw) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"usdc\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Usdc))
+	}
+	{
+		const prefix string = ",\"nonce\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Nonce))
+	}
+	{
+		const prefix string = ",\"fee\":"
+		out.RawString(prefix)
+		out.String(string(in.Fee))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LedgerWithdraw) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid59(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LedgerWithdraw) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid59(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LedgerWithdraw) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid59(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LedgerWithdraw) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid59(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid60(in *jlexer.Lexer, out *LedgerVaultWithdraw) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "vault":
+			out.Vault = string(in.String())
+		case "user":
+			out.User = string(in.String())
+		case "requestedUsd":
+			out.RequestedUsd = string(in.String())
+		case "commission":
+			out.Commission = string(in.String())
+		case "closingCost":
+			out.ClosingCost = string(in.String())
+		case "basis":
+			out.Basis = string(in.String())
+		case "netWithdrawnUsd":
+			out.NetWithdrawnUsd = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid60(out *jwriter.Writer, in LedgerVaultWithdraw) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"vault\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Vault))
+	}
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		out.String(string(in.User))
+	}
+	{
+		const prefix string = ",\"requestedUsd\":"
+		out.RawString(prefix)
+		out.String(string(in.RequestedUsd))
+	}
+	{
+		const prefix string = ",\"commission\":"
+		out.RawString(prefix)
+		out.String(string(in.Commission))
+	}
+	{
+		const prefix string = ",\"closingCost\":"
+		out.RawString(prefix)
+		out.String(string(in.ClosingCost))
+	}
+	{
+		const prefix string = ",\"basis\":"
+		out.RawString(prefix)
+		out.String(string(in.Basis))
+	}
+	{
+		const prefix string = ",\"netWithdrawnUsd\":"
+		out.RawString(prefix)
+		out.String(string(in.NetWithdrawnUsd))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LedgerVaultWithdraw) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid60(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LedgerVaultWithdraw) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid60(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LedgerVaultWithdraw) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid60(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LedgerVaultWithdraw) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid60(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid61(in *jlexer.Lexer, out *LedgerVaultLeaderCommission) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user":
+			out.User = string(in.String())
+		case "usdc":
+			out.Usdc = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid61(out *jwriter.Writer, in LedgerVaultLeaderCommission) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.User))
+	}
+	{
+		const prefix string = ",\"usdc\":"
+		out.RawString(prefix)
+		out.String(string(in.Usdc))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LedgerVaultLeaderCommission) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid61(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LedgerVaultLeaderCommission) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid61(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LedgerVaultLeaderCommission) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid61(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LedgerVaultLeaderCommission) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid61(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid62(in *jlexer.Lexer, out *LedgerVaultDelta) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "vault":
+			out.Vault = string(in.String())
+		case "usdc":
+			out.Usdc = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid62(out *jwriter.Writer, in LedgerVaultDelta) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"vault\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Vault))
+	}
+	{
+		const prefix string = ",\"usdc\":"
+		out.RawString(prefix)
+		out.String(string(in.Usdc))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LedgerVaultDelta) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid62(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LedgerVaultDelta) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid62(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LedgerVaultDelta) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid62(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LedgerVaultDelta) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid62(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid63(in *jlexer.Lexer, out *LedgerUpdate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "time":
+			out.Time = int64(in.Int64())
+		case "hash":
+			out.Hash = string(in.String())
+		case "delta":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Delta).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid63(out *jwriter.Writer, in LedgerUpdate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"hash\":"
+		out.RawString(prefix)
+		out.String(string(in.Hash))
+	}
+	{
+		const prefix string = ",\"delta\":"
+		out.RawString(prefix)
+		out.Raw((in.Delta).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LedgerUpdate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid63(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LedgerUpdate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid63(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LedgerUpdate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid63(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LedgerUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid63(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid64(in *jlexer.Lexer, out *LedgerSubAccountTransfer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "usdc":
+			out.Usdc = string(in.String())
+		case "user":
+			out.User = string(in.String())
+		case "destination":
+			out.Destination = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid64(out *jwriter.Writer, in LedgerSubAccountTransfer) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"usdc\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Usdc))
+	}
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		out.String(string(in.User))
+	}
+	{
+		const prefix string = ",\"destination\":"
+		out.RawString(prefix)
+		out.String(string(in.Destination))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LedgerSubAccountTransfer) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid64(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LedgerSubAccountTransfer) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid64(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LedgerSubAccountTransfer) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid64(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LedgerSubAccountTransfer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid64(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid65(in *jlexer.Lexer, out *LedgerSpotTransfer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "token":
+			out.Token = string(in.String())
+		case "amount":
+			out.Amount = string(in.String())
+		case "usdcValue":
+			out.UsdcValue = string(in.String())
+		case "user":
+			out.User = string(in.String())
+		case "destination":
+			out.Destination = string(in.String())
+		case "fee":
+			out.Fee = string(in.String())
+		case "nativeTokenFee":
+			if in.IsNull() {
+				in.Skip()
+				out.NativeTokenFee = nil
+			} else {
+				if out.NativeTokenFee == nil {
+					out.NativeTokenFee = new(string)
+				}
+				*out.NativeTokenFee = string(in.String())
+			}
+		case "nonce":
+			if in.IsNull() {
+				in.Skip()
+				out.Nonce = nil
+			} else {
+				if out.Nonce == nil {
+					out.Nonce = new(int64)
+				}
+				*out.Nonce = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid65(out *jwriter.Writer, in LedgerSpotTransfer) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Token))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.String(string(in.Amount))
+	}
+	{
+		const prefix string = ",\"usdcValue\":"
+		out.RawString(prefix)
+		out.String(string(in.UsdcValue))
+	}
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		out.String(string(in.User))
+	}
+	{
+		const prefix string = ",\"destination\":"
+		out.RawString(prefix)
+		out.String(string(in.Destination))
+	}
+	{
+		const prefix string = ",\"fee\":"
+		out.RawString(prefix)
+		out.String(string(in.Fee))
+	}
+	if in.NativeTokenFee != nil {
+		const prefix string = ",\"nativeTokenFee\":"
+		out.RawString(prefix)
+		out.String(string(*in.NativeTokenFee))
+	}
+	if in.Nonce != nil {
+		const prefix string = ",\"nonce\":"
+		out.RawString(prefix)
+		out.Int64(int64(*in.Nonce))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LedgerSpotTransfer) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid65(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LedgerSpotTransfer) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid65(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LedgerSpotTransfer) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid65(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LedgerSpotTransfer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid65(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid66(in *jlexer.Lexer, out *LedgerSpotGenesis) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "token":
+			out.Token = string(in.String())
+		case "amount":
+			out.Amount = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid66(out *jwriter.Writer, in LedgerSpotGenesis) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Token))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.String(string(in.Amount))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LedgerSpotGenesis) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid66(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LedgerSpotGenesis) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid66(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LedgerSpotGenesis) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid66(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LedgerSpotGenesis) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid66(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid67(in *jlexer.Lexer, out *LedgerSend) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user":
+			out.User = string(in.String())
+		case "destination":
+			out.Destination = string(in.String())
+		case "sourceDex":
+			out.SourceDex = string(in.String())
+		case "destinationDex":
+			out.DestinationDex = string(in.String())
+		case "token":
+			out.Token = string(in.String())
+		case "amount":
+			out.Amount = string(in.String())
+		case "usdcValue":
+			out.UsdcValue = string(in.String())
+		case "fee":
+			out.Fee = string(in.String())
+		case "nativeTokenFee":
+			out.NativeTokenFee = string(in.String())
+		case "nonce":
+			out.Nonce = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid67(out *jwriter.Writer, in LedgerSend) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.User))
+	}
+	{
+		const prefix string = ",\"destination\":"
+		out.RawString(prefix)
+		out.String(string(in.Destination))
+	}
+	{
+		const prefix string = ",\"sourceDex\":"
+		out.RawString(prefix)
+		out.String(string(in.SourceDex))
+	}
+	{
+		const prefix string = ",\"destinationDex\":"
+		out.RawString(prefix)
+		out.String(string(in.DestinationDex))
+	}
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix)
+		out.String(string(in.Token))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.String(string(in.Amount))
+	}
+	{
+		const prefix string = ",\"usdcValue\":"
+		out.RawString(prefix)
+		out.String(string(in.UsdcValue))
+	}
+	{
+		const prefix string = ",\"fee\":"
+		out.RawString(prefix)
+		out.String(string(in.Fee))
+	}
+	{
+		const prefix string = ",\"nativeTokenFee\":"
+		out.RawString(prefix)
+		out.String(string(in.NativeTokenFee))
+	}
+	{
+		const prefix string = ",\"nonce\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Nonce))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LedgerSend) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid67(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LedgerSend) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid67(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LedgerSend) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid67(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LedgerSend) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid67(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid68(in *jlexer.Lexer, out *LedgerRewardsClaim) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "amount":
+			out.Amount = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid68(out *jwriter.Writer, in LedgerRewardsClaim) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Amount))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LedgerRewardsClaim) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid68(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LedgerRewardsClaim) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid68(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LedgerRewardsClaim) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid68(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LedgerRewardsClaim) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid68(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid69(in *jlexer.Lexer, out *LedgerLiquidation) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "liquidatedNtlPos":
+			out.LiquidatedNtlPos = string(in.String())
+		case "accountValue":
+			out.AccountValue = string(in.String())
+		case "leverageType":
+			out.LeverageType = string(in.String())
+		case "liquidatedPositions":
+			if in.IsNull() {
+				in.Skip()
+				out.LiquidatedPositions = nil
+			} else {
+				in.Delim('[')
+				if out.LiquidatedPositions == nil {
+					if !in.IsDelim(']') {
+						out.LiquidatedPositions = make([]LedgerLiquidatedPosition, 0, 2)
 					} else {
-						out.MarginTables = []MarginTable{}
+						out.LiquidatedPositions = []LedgerLiquidatedPosition{}
 					}
 				} else {
-					out.MarginTables = (out.MarginTables)[:0]
+					out.LiquidatedPositions = (out.LiquidatedPositions)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v58 MarginTable
-					(v58).UnmarshalEasyJSON(in)
-					out.MarginTables = append(out.MarginTables, v58)
+					var v75 LedgerLiquidatedPosition
+					(v75).UnmarshalEasyJSON(in)
+					out.LiquidatedPositions = append(out.LiquidatedPositions, v75)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4485,69 +6953,141 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid43(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid43(out *jwriter.Writer, in Meta) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid69(out *jwriter.Writer, in LedgerLiquidation) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"liquidatedNtlPos\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.LiquidatedNtlPos))
+	}
+	{
+		const prefix string = ",\"accountValue\":"
+		out.RawString(prefix)
+		out.String(string(in.AccountValue))
+	}
+	{
+		const prefix string = ",\"leverageType\":"
+		out.RawString(prefix)
+		out.String(string(in.LeverageType))
+	}
+	{
+		const prefix string = ",\"liquidatedPositions\":"
+		out.RawString(prefix)
+		if in.LiquidatedPositions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v76, v77 := range in.LiquidatedPositions {
+				if v76 > 0 {
+					out.RawByte(',')
+				}
+				(v77).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LedgerLiquidation) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid69(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LedgerLiquidation) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid69(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LedgerLiquidation) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid69(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LedgerLiquidation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid69(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid70(in *jlexer.Lexer, out *LedgerLiquidatedPosition) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "coin":
+			out.Coin = string(in.String())
+		case "szi":
+			out.Szi = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid70(out *jwriter.Writer, in LedgerLiquidatedPosition) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"universe\":"
+		const prefix string = ",\"coin\":"
 		out.RawString(prefix[1:])
-		if in.Universe == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v59, v60 := range in.Universe {
-				if v59 > 0 {
-					out.RawByte(',')
-				}
-				(v60).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Coin))
 	}
 	{
-		const prefix string = ",\"marginTables\":"
+		const prefix string = ",\"szi\":"
 		out.RawString(prefix)
-		if in.MarginTables == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v61, v62 := range in.MarginTables {
-				if v61 > 0 {
-					out.RawByte(',')
-				}
-				(v62).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Szi))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Meta) MarshalJSON() ([]byte, error) {
+func (v LedgerLiquidatedPosition) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid43(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid70(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Meta) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid43(w, v)
+func (v LedgerLiquidatedPosition) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid70(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Meta) UnmarshalJSON(data []byte) error {
+func (v *LedgerLiquidatedPosition) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid43(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid70(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Meta) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid43(l, v)
+func (v *LedgerLiquidatedPosition) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid70(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid44(in *jlexer.Lexer, out *MarginTier) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid71(in *jlexer.Lexer, out *LedgerInternalTransfer) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4566,10 +7106,14 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid44(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "lowerBound":
-			out.LowerBound = string(in.String())
-		case "maxLeverage":
-			out.MaxLeverage = int(in.Int())
+		case "usdc":
+			out.Usdc = string(in.String())
+		case "user":
+			out.User = string(in.String())
+		case "destination":
+			out.Destination = string(in.String())
+		case "fee":
+			out.Fee = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -4580,47 +7124,57 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid44(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid44(out *jwriter.Writer, in MarginTier) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid71(out *jwriter.Writer, in LedgerInternalTransfer) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"lowerBound\":"
+		const prefix string = ",\"usdc\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.LowerBound))
+		out.String(string(in.Usdc))
 	}
 	{
-		const prefix string = ",\"maxLeverage\":"
+		const prefix string = ",\"user\":"
 		out.RawString(prefix)
-		out.Int(int(in.MaxLeverage))
+		out.String(string(in.User))
+	}
+	{
+		const prefix string = ",\"destination\":"
+		out.RawString(prefix)
+		out.String(string(in.Destination))
+	}
+	{
+		const prefix string = ",\"fee\":"
+		out.RawString(prefix)
+		out.String(string(in.Fee))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v MarginTier) MarshalJSON() ([]byte, error) {
+func (v LedgerInternalTransfer) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid44(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid71(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v MarginTier) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid44(w, v)
+func (v LedgerInternalTransfer) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid71(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *MarginTier) UnmarshalJSON(data []byte) error {
+func (v *LedgerInternalTransfer) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid44(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid71(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *MarginTier) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid44(l, v)
+func (v *LedgerInternalTransfer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid71(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid45(in *jlexer.Lexer, out *MarginTable) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid72(in *jlexer.Lexer, out *LedgerDeposit) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4639,33 +7193,8 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid45(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "ID":
-			out.ID = int(in.Int())
-		case "description":
-			out.Description = string(in.String())
-		case "marginTiers":
-			if in.IsNull() {
-				in.Skip()
-				out.MarginTiers = nil
-			} else {
-				in.Delim('[')
-				if out.MarginTiers == nil {
-					if !in.IsDelim(']') {
-						out.MarginTiers = make([]MarginTier, 0, 2)
-					} else {
-						out.MarginTiers = []MarginTier{}
-					}
-				} else {
-					out.MarginTiers = (out.MarginTiers)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v63 MarginTier
-					(v63).UnmarshalEasyJSON(in)
-					out.MarginTiers = append(out.MarginTiers, v63)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "usdc":
+			out.Usdc = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -4676,63 +7205,42 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid45(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid45(out *jwriter.Writer, in MarginTable) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid72(out *jwriter.Writer, in LedgerDeposit) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"ID\":"
+		const prefix string = ",\"usdc\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ID))
-	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	{
-		const prefix string = ",\"marginTiers\":"
-		out.RawString(prefix)
-		if in.MarginTiers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v64, v65 := range in.MarginTiers {
-				if v64 > 0 {
-					out.RawByte(',')
-				}
-				(v65).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Usdc))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v MarginTable) MarshalJSON() ([]byte, error) {
+func (v LedgerDeposit) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid45(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid72(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v MarginTable) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid45(w, v)
+func (v LedgerDeposit) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid72(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *MarginTable) UnmarshalJSON(data []byte) error {
+func (v *LedgerDeposit) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid45(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid72(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *MarginTable) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid45(l, v)
+func (v *LedgerDeposit) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid72(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid46(in *jlexer.Lexer, out *MarginSummary) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid73(in *jlexer.Lexer, out *LedgerCStakingTransfer) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4751,14 +7259,12 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid46(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "accountValue":
-			out.AccountValue = string(in.String())
-		case "totalMarginUsed":
-			out.TotalMarginUsed = string(in.String())
-		case "totalNtlPos":
-			out.TotalNtlPos = string(in.String())
-		case "totalRawUsd":
-			out.TotalRawUsd = string(in.String())
+		case "token":
+			out.Token = string(in.String())
+		case "amount":
+			out.Amount = string(in.String())
+		case "isDeposit":
+			out.IsDeposit = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -4769,57 +7275,52 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid46(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid46(out *jwriter.Writer, in MarginSummary) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid73(out *jwriter.Writer, in LedgerCStakingTransfer) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"accountValue\":"
+		const prefix string = ",\"token\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.AccountValue))
-	}
-	{
-		const prefix string = ",\"totalMarginUsed\":"
-		out.RawString(prefix)
-		out.String(string(in.TotalMarginUsed))
+		out.String(string(in.Token))
 	}
 	{
-		const prefix string = ",\"totalNtlPos\":"
+		const prefix string = ",\"amount\":"
 		out.RawString(prefix)
-		out.String(string(in.TotalNtlPos))
+		out.String(string(in.Amount))
 	}
 	{
-		const prefix string = ",\"totalRawUsd\":"
+		const prefix string = ",\"isDeposit\":"
 		out.RawString(prefix)
-		out.String(string(in.TotalRawUsd))
+		out.Bool(bool(in.IsDeposit))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v MarginSummary) MarshalJSON() ([]byte, error) {
+func (v LedgerCStakingTransfer) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid46(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid73(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v MarginSummary) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid46(w, v)
+func (v LedgerCStakingTransfer) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid73(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *MarginSummary) UnmarshalJSON(data []byte) error {
+func (v *LedgerCStakingTransfer) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid46(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid73(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *MarginSummary) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid46(l, v)
+func (v *LedgerCStakingTransfer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid73(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid47(in *jlexer.Lexer, out *MMTier) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid74(in *jlexer.Lexer, out *LedgerAccountClassTransfer) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4838,10 +7339,10 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid47(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "add":
-			out.Add = string(in.String())
-		case "makerFractionCutoff":
-			out.MakerFractionCutoff = string(in.String())
+		case "usdc":
+			out.Usdc = string(in.String())
+		case "toPerp":
+			out.ToPerp = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -4852,47 +7353,47 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid47(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid47(out *jwriter.Writer, in MMTier) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid74(out *jwriter.Writer, in LedgerAccountClassTransfer) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"add\":"
+		const prefix string = ",\"usdc\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Add))
+		out.String(string(in.Usdc))
 	}
 	{
-		const prefix string = ",\"makerFractionCutoff\":"
+		const prefix string = ",\"toPerp\":"
 		out.RawString(prefix)
-		out.String(string(in.MakerFractionCutoff))
+		out.Bool(bool(in.ToPerp))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v MMTier) MarshalJSON() ([]byte, error) {
+func (v LedgerAccountClassTransfer) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid47(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid74(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v MMTier) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid47(w, v)
+func (v LedgerAccountClassTransfer) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid74(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *MMTier) UnmarshalJSON(data []byte) error {
+func (v *LedgerAccountClassTransfer) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid47(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid74(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *MMTier) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid47(l, v)
+func (v *LedgerAccountClassTransfer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid74(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid48(in *jlexer.Lexer, out *LimitOrderType) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid75(in *jlexer.Lexer, out *LeadingVault) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4911,8 +7412,10 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid48(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "tif":
-			out.Tif = Tif(in.String())
+		case "address":
+			out.Address = string(in.String())
+		case "name":
+			out.Name = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -4923,42 +7426,47 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid48(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid48(out *jwriter.Writer, in LimitOrderType) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid75(out *jwriter.Writer, in LeadingVault) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"tif\":"
+		const prefix string = ",\"address\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Tif))
+		out.String(string(in.Address))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v LimitOrderType) MarshalJSON() ([]byte, error) {
+func (v LeadingVault) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid48(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid75(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v LimitOrderType) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid48(w, v)
+func (v LeadingVault) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid75(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *LimitOrderType) UnmarshalJSON(data []byte) error {
+func (v *LeadingVault) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid48(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid75(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *LimitOrderType) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid48(l, v)
+func (v *LeadingVault) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid75(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid49(in *jlexer.Lexer, out *Leverage) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid76(in *jlexer.Lexer, out *FundingHistory) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4977,20 +7485,14 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid49(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "type":
-			out.Type = string(in.String())
-		case "value":
-			out.Value = int(in.Int())
-		case "rawUsd":
-			if in.IsNull() {
-				in.Skip()
-				out.RawUsd = nil
-			} else {
-				if out.RawUsd == nil {
-					out.RawUsd = new(string)
-				}
-				*out.RawUsd = string(in.String())
-			}
+		case "coin":
+			out.Coin = string(in.String())
+		case "fundingRate":
+			out.FundingRate = string(in.String())
+		case "premium":
+			out.Premium = string(in.String())
+		case "time":
+			out.Time = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -5001,52 +7503,57 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid49(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid49(out *jwriter.Writer, in Leverage) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid76(out *jwriter.Writer, in FundingHistory) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"coin\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
+		out.String(string(in.Coin))
 	}
 	{
-		const prefix string = ",\"value\":"
+		const prefix string = ",\"fundingRate\":"
 		out.RawString(prefix)
-		out.Int(int(in.Value))
+		out.String(string(in.FundingRate))
 	}
-	if in.RawUsd != nil {
-		const prefix string = ",\"rawUsd\":"
+	{
+		const prefix string = ",\"premium\":"
 		out.RawString(prefix)
-		out.String(string(*in.RawUsd))
+		out.String(string(in.Premium))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Leverage) MarshalJSON() ([]byte, error) {
+func (v FundingHistory) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid49(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid76(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Leverage) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid49(w, v)
+func (v FundingHistory) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid76(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Leverage) UnmarshalJSON(data []byte) error {
+func (v *FundingHistory) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid49(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid76(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Leverage) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid49(l, v)
+func (v *FundingHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid76(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid50(in *jlexer.Lexer, out *FundingHistory) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid77(in *jlexer.Lexer, out *FillLiquidation) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5065,14 +7572,12 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid50(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "coin":
-			out.Coin = string(in.String())
-		case "fundingRate":
-			out.FundingRate = string(in.String())
-		case "premium":
-			out.Premium = string(in.String())
-		case "time":
-			out.Time = int64(in.Int64())
+		case "liquidatedUser":
+			out.LiquidatedUser = string(in.String())
+		case "markPx":
+			out.MarkPx = string(in.String())
+		case "method":
+			out.Method = FillLiquidationMethod(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -5083,57 +7588,58 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid50(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid50(out *jwriter.Writer, in FundingHistory) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid77(out *jwriter.Writer, in FillLiquidation) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"coin\":"
+	if in.LiquidatedUser != "" {
+		const prefix string = ",\"liquidatedUser\":"
+		first = false
 		out.RawString(prefix[1:])
-		out.String(string(in.Coin))
-	}
-	{
-		const prefix string = ",\"fundingRate\":"
-		out.RawString(prefix)
-		out.String(string(in.FundingRate))
+		out.String(string(in.LiquidatedUser))
 	}
 	{
-		const prefix string = ",\"premium\":"
-		out.RawString(prefix)
-		out.String(string(in.Premium))
+		const prefix string = ",\"markPx\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.MarkPx))
 	}
 	{
-		const prefix string = ",\"time\":"
+		const prefix string = ",\"method\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Time))
+		out.String(string(in.Method))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FundingHistory) MarshalJSON() ([]byte, error) {
+func (v FillLiquidation) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid50(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid77(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FundingHistory) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid50(w, v)
+func (v FillLiquidation) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid77(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FundingHistory) UnmarshalJSON(data []byte) error {
+func (v *FillLiquidation) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid50(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid77(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FundingHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid50(l, v)
+func (v *FillLiquidation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid77(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid51(in *jlexer.Lexer, out *Fill) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid78(in *jlexer.Lexer, out *Fill) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5182,6 +7688,36 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid51(in *jlexer.Lexer, ou
 			out.BuilderFee = string(in.String())
 		case "tid":
 			out.Tid = int64(in.Int64())
+		case "cloid":
+			if in.IsNull() {
+				in.Skip()
+				out.Cloid = nil
+			} else {
+				if out.Cloid == nil {
+					out.Cloid = new(string)
+				}
+				*out.Cloid = string(in.String())
+			}
+		case "liquidation":
+			if in.IsNull() {
+				in.Skip()
+				out.Liquidation = nil
+			} else {
+				if out.Liquidation == nil {
+					out.Liquidation = new(FillLiquidation)
+				}
+				(*out.Liquidation).UnmarshalEasyJSON(in)
+			}
+		case "twapId":
+			if in.IsNull() {
+				in.Skip()
+				out.TwapID = nil
+			} else {
+				if out.TwapID == nil {
+					out.TwapID = new(int64)
+				}
+				*out.TwapID = int64(in.Int64())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -5192,7 +7728,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid51(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid51(out *jwriter.Writer, in Fill) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid78(out *jwriter.Writer, in Fill) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5271,33 +7807,48 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid51(out *jwriter.Writer,
 		out.RawString(prefix)
 		out.Int64(int64(in.Tid))
 	}
+	if in.Cloid != nil {
+		const prefix string = ",\"cloid\":"
+		out.RawString(prefix)
+		out.String(string(*in.Cloid))
+	}
+	if in.Liquidation != nil {
+		const prefix string = ",\"liquidation\":"
+		out.RawString(prefix)
+		(*in.Liquidation).MarshalEasyJSON(out)
+	}
+	if in.TwapID != nil {
+		const prefix string = ",\"twapId\":"
+		out.RawString(prefix)
+		out.Int64(int64(*in.TwapID))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v Fill) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid51(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid78(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Fill) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid51(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid78(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Fill) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid51(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid78(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Fill) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid51(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid78(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid52(in *jlexer.Lexer, out *FeeSchedule) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid79(in *jlexer.Lexer, out *FeeSchedule) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5334,7 +7885,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid52(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid52(out *jwriter.Writer, in FeeSchedule) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid79(out *jwriter.Writer, in FeeSchedule) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5364,27 +7915,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid52(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v FeeSchedule) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid52(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid79(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v FeeSchedule) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid52(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid79(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *FeeSchedule) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid52(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid79(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *FeeSchedule) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid52(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid79(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid53(in *jlexer.Lexer, out *EvmContract) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid80(in *jlexer.Lexer, out *ExtraAgent) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5405,8 +7956,10 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid53(in *jlexer.Lexer, ou
 		switch key {
 		case "address":
 			out.Address = string(in.String())
-		case "evm_extra_wei_decimals":
-			out.EvmExtraWeiDecimals = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		case "validUntil":
+			out.ValidUntil = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -5417,7 +7970,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid53(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid53(out *jwriter.Writer, in EvmContract) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid80(out *jwriter.Writer, in ExtraAgent) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5427,37 +7980,42 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid53(out *jwriter.Writer,
 		out.String(string(in.Address))
 	}
 	{
-		const prefix string = ",\"evm_extra_wei_decimals\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.Int(int(in.EvmExtraWeiDecimals))
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"validUntil\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ValidUntil))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v EvmContract) MarshalJSON() ([]byte, error) {
+func (v ExtraAgent) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid53(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid80(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v EvmContract) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid53(w, v)
+func (v ExtraAgent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid80(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *EvmContract) UnmarshalJSON(data []byte) error {
+func (v *ExtraAgent) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid53(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid80(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *EvmContract) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid53(l, v)
+func (v *ExtraAgent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid80(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid54(in *jlexer.Lexer, out *CreateVaultResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid81(in *jlexer.Lexer, out *EvmContract) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5476,12 +8034,10 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid54(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "status":
-			out.Status = string(in.String())
-		case "data":
-			out.Data = string(in.String())
-		case "error":
-			out.Error = string(in.String())
+		case "address":
+			out.Address = string(in.String())
+		case "evm_extra_wei_decimals":
+			out.EvmExtraWeiDecimals = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -5492,52 +8048,47 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid54(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid54(out *jwriter.Writer, in CreateVaultResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid81(out *jwriter.Writer, in EvmContract) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"address\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Status))
-	}
-	if in.Data != "" {
-		const prefix string = ",\"data\":"
-		out.RawString(prefix)
-		out.String(string(in.Data))
+		out.String(string(in.Address))
 	}
-	if in.Error != "" {
-		const prefix string = ",\"error\":"
+	{
+		const prefix string = ",\"evm_extra_wei_decimals\":"
 		out.RawString(prefix)
-		out.String(string(in.Error))
+		out.Int(int(in.EvmExtraWeiDecimals))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CreateVaultResponse) MarshalJSON() ([]byte, error) {
+func (v EvmContract) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid54(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid81(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CreateVaultResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid54(w, v)
+func (v EvmContract) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid81(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CreateVaultResponse) UnmarshalJSON(data []byte) error {
+func (v *EvmContract) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid54(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid81(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CreateVaultResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid54(l, v)
+func (v *EvmContract) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid81(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid55(in *jlexer.Lexer, out *CreateSubAccountResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid82(in *jlexer.Lexer, out *CreateVaultResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5559,15 +8110,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid55(in *jlexer.Lexer, ou
 		case "status":
 			out.Status = string(in.String())
 		case "data":
-			if in.IsNull() {
-				in.Skip()
-				out.Data = nil
-			} else {
-				if out.Data == nil {
-					out.Data = new(SubAccount)
-				}
-				(*out.Data).UnmarshalEasyJSON(in)
-			}
+			out.Data = string(in.String())
 		case "error":
 			out.Error = string(in.String())
 		default:
@@ -5580,7 +8123,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid55(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid55(out *jwriter.Writer, in CreateSubAccountResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid82(out *jwriter.Writer, in CreateVaultResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5589,10 +8132,10 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid55(out *jwriter.Writer,
 		out.RawString(prefix[1:])
 		out.String(string(in.Status))
 	}
-	if in.Data != nil {
+	if in.Data != "" {
 		const prefix string = ",\"data\":"
 		out.RawString(prefix)
-		(*in.Data).MarshalEasyJSON(out)
+		out.String(string(in.Data))
 	}
 	if in.Error != "" {
 		const prefix string = ",\"error\":"
@@ -5603,29 +8146,29 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid55(out *jwriter.Writer,
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CreateSubAccountResponse) MarshalJSON() ([]byte, error) {
+func (v CreateVaultResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid55(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid82(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CreateSubAccountResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid55(w, v)
+func (v CreateVaultResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid82(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CreateSubAccountResponse) UnmarshalJSON(data []byte) error {
+func (v *CreateVaultResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid55(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid82(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CreateSubAccountResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid55(l, v)
+func (v *CreateVaultResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid82(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid56(in *jlexer.Lexer, out *Cloid) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid83(in *jlexer.Lexer, out *CreateSubAccountResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5644,8 +8187,20 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid56(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "Value":
-			out.Value = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "data":
+			if in.IsNull() {
+				in.Skip()
+				out.Data = nil
+			} else {
+				if out.Data == nil {
+					out.Data = new(SubAccount)
+				}
+				(*out.Data).UnmarshalEasyJSON(in)
+			}
+		case "error":
+			out.Error = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -5656,42 +8211,52 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid56(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid56(out *jwriter.Writer, in Cloid) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid83(out *jwriter.Writer, in CreateSubAccountResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Value\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Value))
+		out.String(string(in.Status))
+	}
+	if in.Data != nil {
+		const prefix string = ",\"data\":"
+		out.RawString(prefix)
+		(*in.Data).MarshalEasyJSON(out)
+	}
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Cloid) MarshalJSON() ([]byte, error) {
+func (v CreateSubAccountResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid56(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid83(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Cloid) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid56(w, v)
+func (v CreateSubAccountResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid83(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Cloid) UnmarshalJSON(data []byte) error {
+func (v *CreateSubAccountResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid56(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid83(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Cloid) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid56(l, v)
+func (v *CreateSubAccountResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid83(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid57(in *jlexer.Lexer, out *CancelResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid84(in *jlexer.Lexer, out *CancelResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5734,7 +8299,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid57(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid57(out *jwriter.Writer, in CancelResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid84(out *jwriter.Writer, in CancelResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5759,27 +8324,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid57(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v CancelResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid57(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid84(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CancelResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid57(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid84(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CancelResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid57(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid84(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CancelResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid57(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid84(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid58(in *jlexer.Lexer, out *CancelRequest) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid85(in *jlexer.Lexer, out *CancelRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5812,7 +8377,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid58(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid58(out *jwriter.Writer, in CancelRequest) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid85(out *jwriter.Writer, in CancelRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5832,27 +8397,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid58(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v CancelRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid58(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid85(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CancelRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid58(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid85(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CancelRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid58(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid85(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CancelRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid58(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid85(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid59(in *jlexer.Lexer, out *CancelByCloidRequest) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid86(in *jlexer.Lexer, out *CancelByCloidRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5874,7 +8439,9 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid59(in *jlexer.Lexer, ou
 		case "coin":
 			out.Coin = string(in.String())
 		case "cloid":
-			out.Cloid = string(in.String())
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Cloid).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -5885,7 +8452,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid59(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid59(out *jwriter.Writer, in CancelByCloidRequest) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid86(out *jwriter.Writer, in CancelByCloidRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5897,7 +8464,7 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid59(out *jwriter.Writer,
 	{
 		const prefix string = ",\"cloid\":"
 		out.RawString(prefix)
-		out.String(string(in.Cloid))
+		out.Raw((in.Cloid).MarshalJSON())
 	}
 	out.RawByte('}')
 }
@@ -5905,27 +8472,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid59(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v CancelByCloidRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid59(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid86(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CancelByCloidRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid59(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid86(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CancelByCloidRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid59(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid86(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CancelByCloidRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid59(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid86(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid60(in *jlexer.Lexer, out *BulkOrderResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid87(in *jlexer.Lexer, out *BulkOrderResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5954,7 +8521,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid60(in *jlexer.Lexer, ou
 				in.Delim('[')
 				if out.Data == nil {
 					if !in.IsDelim(']') {
-						out.Data = make([]OrderStatus, 0, 2)
+						out.Data = make([]OrderStatus, 0, 1)
 					} else {
 						out.Data = []OrderStatus{}
 					}
@@ -5962,9 +8529,11 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid60(in *jlexer.Lexer, ou
 					out.Data = (out.Data)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v66 OrderStatus
-					easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid39(in, &v66)
-					out.Data = append(out.Data, v66)
+					var v78 OrderStatus
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v78).UnmarshalJSON(data))
+					}
+					out.Data = append(out.Data, v78)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -5981,7 +8550,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid60(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid60(out *jwriter.Writer, in BulkOrderResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid87(out *jwriter.Writer, in BulkOrderResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5995,11 +8564,11 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid60(out *jwriter.Writer,
 		out.RawString(prefix)
 		{
 			out.RawByte('[')
-			for v67, v68 := range in.Data {
-				if v67 > 0 {
+			for v79, v80 := range in.Data {
+				if v79 > 0 {
 					out.RawByte(',')
 				}
-				easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid39(out, v68)
+				out.Raw((v80).MarshalJSON())
 			}
 			out.RawByte(']')
 		}
@@ -6015,27 +8584,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid60(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v BulkOrderResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid60(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid87(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BulkOrderResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid60(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid87(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BulkOrderResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid60(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid87(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BulkOrderResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid60(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid87(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid61(in *jlexer.Lexer, out *BulkCancelResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid88(in *jlexer.Lexer, out *BulkCancelResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6072,9 +8641,9 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid61(in *jlexer.Lexer, ou
 					out.Data = (out.Data)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v69 OpenOrder
-					(v69).UnmarshalEasyJSON(in)
-					out.Data = append(out.Data, v69)
+					var v81 OpenOrder
+					(v81).UnmarshalEasyJSON(in)
+					out.Data = append(out.Data, v81)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -6091,7 +8660,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid61(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid61(out *jwriter.Writer, in BulkCancelResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid88(out *jwriter.Writer, in BulkCancelResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6105,11 +8674,11 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid61(out *jwriter.Writer,
 		out.RawString(prefix)
 		{
 			out.RawByte('[')
-			for v70, v71 := range in.Data {
-				if v70 > 0 {
+			for v82, v83 := range in.Data {
+				if v82 > 0 {
 					out.RawByte(',')
 				}
-				(v71).MarshalEasyJSON(out)
+				(v83).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -6125,27 +8694,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid61(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v BulkCancelResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid61(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid88(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BulkCancelResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid61(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid88(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BulkCancelResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid61(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid88(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BulkCancelResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid61(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid88(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid62(in *jlexer.Lexer, out *BuilderInfo) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid89(in *jlexer.Lexer, out *BuilderInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6178,7 +8747,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid62(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid62(out *jwriter.Writer, in BuilderInfo) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid89(out *jwriter.Writer, in BuilderInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6198,27 +8767,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid62(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v BuilderInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid62(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid89(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BuilderInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid62(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid89(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BuilderInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid62(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid89(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BuilderInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid62(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid89(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid63(in *jlexer.Lexer, out *AssetPosition) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid90(in *jlexer.Lexer, out *AssetPosition) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6251,7 +8820,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid63(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid63(out *jwriter.Writer, in AssetPosition) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid90(out *jwriter.Writer, in AssetPosition) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6271,27 +8840,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid63(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v AssetPosition) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid63(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid90(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AssetPosition) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid63(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid90(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AssetPosition) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid63(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid90(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AssetPosition) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid63(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid90(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid64(in *jlexer.Lexer, out *AssetInfo) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid91(in *jlexer.Lexer, out *AssetInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6314,6 +8883,14 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid64(in *jlexer.Lexer, ou
 			out.Name = string(in.String())
 		case "szDecimals":
 			out.SzDecimals = int(in.Int())
+		case "maxLeverage":
+			out.MaxLeverage = int(in.Int())
+		case "marginTableId":
+			out.MarginTableID = int(in.Int())
+		case "onlyIsolated":
+			out.OnlyIsolated = bool(in.Bool())
+		case "isDelisted":
+			out.IsDelisted = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -6324,7 +8901,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid64(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid64(out *jwriter.Writer, in AssetInfo) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid91(out *jwriter.Writer, in AssetInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6338,33 +8915,53 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid64(out *jwriter.Writer,
 		out.RawString(prefix)
 		out.Int(int(in.SzDecimals))
 	}
+	if in.MaxLeverage != 0 {
+		const prefix string = ",\"maxLeverage\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxLeverage))
+	}
+	if in.MarginTableID != 0 {
+		const prefix string = ",\"marginTableId\":"
+		out.RawString(prefix)
+		out.Int(int(in.MarginTableID))
+	}
+	if in.OnlyIsolated {
+		const prefix string = ",\"onlyIsolated\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.OnlyIsolated))
+	}
+	if in.IsDelisted {
+		const prefix string = ",\"isDelisted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsDelisted))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v AssetInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid64(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid91(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AssetInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid64(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid91(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AssetInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid64(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid91(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AssetInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid64(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid91(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid65(in *jlexer.Lexer, out *AssetCtx) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid92(in *jlexer.Lexer, out *AssetCtx) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6415,9 +9012,9 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid65(in *jlexer.Lexer, ou
 					out.ImpactPxs = (out.ImpactPxs)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v72 string
-					v72 = string(in.String())
-					out.ImpactPxs = append(out.ImpactPxs, v72)
+					var v84 string
+					v84 = string(in.String())
+					out.ImpactPxs = append(out.ImpactPxs, v84)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -6434,7 +9031,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid65(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid65(out *jwriter.Writer, in AssetCtx) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid92(out *jwriter.Writer, in AssetCtx) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6485,11 +9082,11 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid65(out *jwriter.Writer,
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v73, v74 := range in.ImpactPxs {
-				if v73 > 0 {
+			for v85, v86 := range in.ImpactPxs {
+				if v85 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v74))
+				out.String(string(v86))
 			}
 			out.RawByte(']')
 		}
@@ -6505,27 +9102,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid65(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v AssetCtx) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid65(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid92(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AssetCtx) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid65(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid92(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AssetCtx) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid65(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid92(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AssetCtx) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid65(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid92(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid66(in *jlexer.Lexer, out *ApprovalResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid93(in *jlexer.Lexer, out *ApprovalResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6560,7 +9157,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid66(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid66(out *jwriter.Writer, in ApprovalResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid93(out *jwriter.Writer, in ApprovalResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6585,27 +9182,27 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid66(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v ApprovalResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid66(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid93(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ApprovalResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid66(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid93(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ApprovalResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid66(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid93(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ApprovalResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid66(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid93(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid67(in *jlexer.Lexer, out *AgentApprovalResponse) {
+func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid94(in *jlexer.Lexer, out *AgentApprovalResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6630,6 +9227,10 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid67(in *jlexer.Lexer, ou
 			out.TxHash = string(in.String())
 		case "error":
 			out.Error = string(in.String())
+		case "response":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Response).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -6640,7 +9241,7 @@ func easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid67(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid67(out *jwriter.Writer, in AgentApprovalResponse) {
+func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid94(out *jwriter.Writer, in AgentApprovalResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6659,29 +9260,34 @@ func easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid67(out *jwriter.Writer,
 		out.RawString(prefix)
 		out.String(string(in.Error))
 	}
+	if len(in.Response) != 0 {
+		const prefix string = ",\"response\":"
+		out.RawString(prefix)
+		out.Raw((in.Response).MarshalJSON())
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v AgentApprovalResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid67(&w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid94(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AgentApprovalResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid67(w, v)
+	easyjson6601e8cdEncodeGithubComSoniricoGoHyperliquid94(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AgentApprovalResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid67(&r, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid94(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AgentApprovalResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid67(l, v)
+	easyjson6601e8cdDecodeGithubComSoniricoGoHyperliquid94(l, v)
 }
@@ -0,0 +1,353 @@
+package hyperliquid
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// DefaultMinNotional is the smallest order value the exchange accepts, in USD
+	DefaultMinNotional = 10.0
+
+	perpPriceMaxDecimals = 6
+	spotPriceMaxDecimals = 8
+	priceMaxSigFigs      = 5
+
+	// marginTableSimpleLimit is the id under which a margin table is not
+	// listed in meta and stands for a single tier with that max leverage
+	marginTableSimpleLimit = 50
+)
+
+// perpLimits are the meta fields of a perp that orders are checked against
+type perpLimits struct {
+	maxLeverage   int
+	marginTableID int
+	onlyIsolated  bool
+	delisted      bool
+}
+
+// OrderValidator checks orders against exchange rules before they are signed,
+// so that obvious rejects are caught locally. Rules that need data the
+// validator was not given are skipped: without a user state there is no
+// reduce-only check, without meta no delisting or leverage check. Leverage is
+// taken from the position, or for coins without one from SetLeverage; coins
+// with neither are not checked against their margin tiers.
+//
+// Attach it to an Exchange with SetValidator. It is safe for concurrent use.
+type OrderValidator struct {
+	// MinNotional is the minimum order value in USD. Zero means
+	// DefaultMinNotional.
+	MinNotional float64
+
+	mu           sync.RWMutex
+	perps        map[string]perpLimits
+	marginTables map[int][]MarginTier
+	positions    map[string]Position
+	hasPositions bool
+	leverages    map[string]Leverage
+	oiCapped     map[string]struct{}
+}
+
+func NewOrderValidator() *OrderValidator {
+	return &OrderValidator{
+		perps:        make(map[string]perpLimits),
+		marginTables: make(map[int][]MarginTier),
+		positions:    make(map[string]Position),
+		leverages:    make(map[string]Leverage),
+		oiCapped:     make(map[string]struct{}),
+	}
+}
+
+// SetMeta loads the perp universe and margin tables of a dex. Call it once
+// per dex.
+func (v *OrderValidator) SetMeta(meta *Meta) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	for _, asset := range meta.Universe {
+		v.perps[asset.Name] = perpLimits{
+			maxLeverage:   asset.MaxLeverage,
+			marginTableID: asset.MarginTableID,
+			onlyIsolated:  asset.OnlyIsolated,
+			delisted:      asset.IsDelisted,
+		}
+	}
+	for _, table := range meta.MarginTables {
+		tiers := append([]MarginTier(nil), table.MarginTiers...)
+		sort.Slice(tiers, func(i, j int) bool {
+			return parseFloat(tiers[i].LowerBound) < parseFloat(tiers[j].LowerBound)
+		})
+		v.marginTables[table.ID] = tiers
+	}
+}
+
+// SetUserState replaces the positions orders are checked against
+func (v *OrderValidator) SetUserState(state *UserState) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.positions = make(map[string]Position, len(state.AssetPositions))
+	for _, assetPos := range state.AssetPositions {
+		v.positions[assetPos.Position.Coin] = assetPos.Position
+	}
+	v.hasPositions = true
+}
+
+// SetLeverage sets the leverage configured for coin, e.g. from
+// Info.UserActiveAssetData. Orders opening a position on coin are checked
+// against it.
+func (v *OrderValidator) SetLeverage(coin string, leverage Leverage) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.leverages[coin] = leverage
+}
+
+// SetOpenInterestCaps replaces the list of perps at their open interest cap
+func (v *OrderValidator) SetOpenInterestCaps(coins []string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.oiCapped = make(map[string]struct{}, len(coins))
+	for _, coin := range coins {
+		v.oiCapped[coin] = struct{}{}
+	}
+}
+
+// Refresh loads meta, the user state of user and the open interest caps
+func (v *OrderValidator) Refresh(info *Info, user string) error {
+	meta, err := info.Meta()
+	if err != nil {
+		return err
+	}
+	state, err := info.UserState(user)
+	if err != nil {
+		return err
+	}
+	capped, err := info.PerpsAtOpenInterestCap()
+	if err != nil {
+		return err
+	}
+
+	v.SetMeta(meta)
+	v.SetUserState(state)
+	v.SetOpenInterestCaps(capped)
+	return nil
+}
+
+// RefreshLeverage loads the leverage user configured for each of coins
+func (v *OrderValidator) RefreshLeverage(info *Info, user string, coins ...string) error {
+	for _, coin := range coins {
+		data, err := info.UserActiveAssetData(user, coin)
+		if err != nil {
+			return err
+		}
+		v.SetLeverage(coin, data.Leverage)
+	}
+	return nil
+}
+
+// MaxLeverage returns the max leverage of coin for a position of the given
+// notional, or 0 when the coin is unknown.
+func (v *OrderValidator) MaxLeverage(coin string, notional float64) int {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.maxLeverage(coin, notional)
+}
+
+func (v *OrderValidator) maxLeverage(coin string, notional float64) int {
+	limits, ok := v.perps[coin]
+	if !ok {
+		return 0
+	}
+
+	tiers, ok := v.marginTables[limits.marginTableID]
+	if !ok || limits.marginTableID < marginTableSimpleLimit {
+		return limits.maxLeverage
+	}
+
+	maxLeverage := limits.maxLeverage
+	for _, tier := range tiers {
+		if notional < parseFloat(tier.LowerBound) {
+			break
+		}
+		maxLeverage = tier.MaxLeverage
+	}
+	return maxLeverage
+}
+
+// Validate checks orders sent with the given grouping and returns
+// ValidationErrors, or nil when every order passes. info resolves coin names
+// and size decimals. In a normalTpsl group the first order is the entry, and
+// the TP/SL legs after it are checked against the position it opens.
+func (v *OrderValidator) Validate(info *Info, orders []CreateOrderRequest, grouping Grouping) error {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	var errs ValidationErrors
+	for i, order := range orders {
+		var pending float64
+		if grouping == GroupingNormalTpsl && i > 0 && orders[0].Coin == order.Coin {
+			pending = signedSize(orders[0])
+		}
+		for _, err := range v.validateOrder(info, order, pending) {
+			err.Order = i
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateOrder checks order as if pending was already added to the
+// position in its coin
+func (v *OrderValidator) validateOrder(
+	info *Info,
+	order CreateOrderRequest,
+	pending float64,
+) []ValidationError {
+	var errs []ValidationError
+	fail := func(field, format string, args ...any) {
+		errs = append(errs, ValidationError{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	coin, ok := info.nameToCoin[order.Coin]
+	if !ok {
+		fail("coin", "unknown coin %q", order.Coin)
+		return errs
+	}
+	asset := info.coinToAsset[coin]
+	szDecimals := info.assetToDecimal[asset]
+	isSpot := isSpotAsset(asset)
+
+	maxDecimals := perpPriceMaxDecimals
+	if isSpot {
+		maxDecimals = spotPriceMaxDecimals
+	}
+
+	if order.Size <= 0 {
+		fail("size", "must be positive")
+	} else if decimals := decimalPlaces(order.Size); decimals > szDecimals {
+		fail("size", "%s has %d decimals, %s allows %d", formatWire(order.Size), decimals, order.Coin, szDecimals)
+	}
+
+	if msg := checkPrice(order.Price, maxDecimals-szDecimals); msg != "" {
+		fail("price", "%s", msg)
+	}
+	if order.OrderType.Trigger != nil {
+		if msg := checkPrice(order.OrderType.Trigger.TriggerPx, maxDecimals-szDecimals); msg != "" {
+			fail("triggerPx", "%s", msg)
+		}
+	}
+
+	minNotional := v.MinNotional
+	if minNotional == 0 {
+		minNotional = DefaultMinNotional
+	}
+	notional := order.Price * order.Size
+	if !order.ReduceOnly && order.Size > 0 && notional < minNotional {
+		fail("size", "order value %s is below the minimum of %s", formatWire(notional), formatWire(minNotional))
+	}
+
+	if isSpot {
+		return errs
+	}
+
+	limits, hasLimits := v.perps[coin]
+	if hasLimits && limits.delisted {
+		fail("coin", "%s is delisted", order.Coin)
+	}
+
+	position, hasPosition := v.positions[coin]
+	szi := parseFloat(position.Szi) + pending
+	reduces := (order.IsBuy && szi < 0) || (!order.IsBuy && szi > 0)
+
+	if order.ReduceOnly && v.hasPositions && !reduces {
+		if szi == 0 {
+			fail("reduceOnly", "no open %s position to reduce", order.Coin)
+		} else {
+			fail("reduceOnly", "order would increase the %s position", order.Coin)
+		}
+	}
+
+	if _, capped := v.oiCapped[coin]; capped && !order.ReduceOnly && !reduces {
+		fail("coin", "%s is at its open interest cap, only orders that reduce a position are accepted", order.Coin)
+	}
+
+	leverage, hasLeverage := position.Leverage, hasPosition
+	if !hasPosition {
+		leverage, hasLeverage = v.leverages[coin]
+	}
+
+	if hasLeverage && hasLimits {
+		if limits.onlyIsolated && leverage.Type == "cross" {
+			fail("leverage", "%s only supports isolated margin", order.Coin)
+		}
+
+		newNotional := abs(szi+signedSize(order)) * order.Price
+		maxLeverage := v.maxLeverage(coin, newNotional)
+		if maxLeverage > 0 && leverage.Value > maxLeverage {
+			fail(
+				"leverage",
+				"leverage %dx exceeds the max of %dx for a %s position of %s",
+				leverage.Value, maxLeverage, order.Coin, formatWire(newNotional),
+			)
+		}
+	}
+
+	return errs
+}
+
+// signedSize returns the size of order, negative for sells
+func signedSize(order CreateOrderRequest) float64 {
+	if order.IsBuy {
+		return order.Size
+	}
+	return -order.Size
+}
+
+// checkPrice applies the exchange tick rules: at most 5 significant figures
+// and maxDecimals decimals. Integer prices are always valid.
+func checkPrice(px float64, maxDecimals int) string {
+	if px <= 0 {
+		return "must be positive"
+	}
+	if px == float64(int64(px)) {
+		return ""
+	}
+	if decimals := decimalPlaces(px); decimals > maxDecimals {
+		return fmt.Sprintf("%s has %d decimals, at most %d are allowed", formatWire(px), decimals, maxDecimals)
+	}
+	if figs := sigFigs(px); figs > priceMaxSigFigs {
+		return fmt.Sprintf("%s has %d significant figures, at most %d are allowed", formatWire(px), figs, priceMaxSigFigs)
+	}
+	return ""
+}
+
+// formatWire formats x the shortest way that round trips
+func formatWire(x float64) string {
+	return strconv.FormatFloat(x, 'f', -1, 64)
+}
+
+// decimalPlaces returns the number of decimals of the shortest form of x
+func decimalPlaces(x float64) int {
+	_, frac, found := strings.Cut(formatWire(abs(x)), ".")
+	if !found {
+		return 0
+	}
+	return len(frac)
+}
+
+// sigFigs returns the number of significant figures of the shortest form of x
+func sigFigs(x float64) int {
+	digits := strings.Replace(formatWire(abs(x)), ".", "", 1)
+	digits = strings.TrimLeft(digits, "0")
+	if !strings.Contains(formatWire(abs(x)), ".") {
+		digits = strings.TrimRight(digits, "0")
+	}
+	return len(digits)
+}
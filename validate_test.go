@@ -0,0 +1,348 @@
+package hyperliquid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestValidator(prepare func(meta *Meta)) *OrderValidator {
+	meta := &Meta{
+		Universe: []AssetInfo{
+			{Name: "BTC", SzDecimals: 5, MaxLeverage: 40, MarginTableID: 56},
+			{Name: "ETH", SzDecimals: 4, MaxLeverage: 25, MarginTableID: 25},
+		},
+		MarginTables: []MarginTable{
+			{
+				ID: 56,
+				MarginTiers: []MarginTier{
+					{LowerBound: "1000000", MaxLeverage: 20},
+					{LowerBound: "0", MaxLeverage: 40},
+				},
+			},
+		},
+	}
+	if prepare != nil {
+		prepare(meta)
+	}
+
+	validator := NewOrderValidator()
+	validator.SetMeta(meta)
+	validator.SetUserState(&UserState{AssetPositions: []AssetPosition{
+		{Position: Position{Coin: "BTC", Szi: "0.5", Leverage: Leverage{Type: "cross", Value: 30}}},
+	}})
+	return validator
+}
+
+func limitOrder(coin string, isBuy bool, px, sz float64) CreateOrderRequest {
+	return CreateOrderRequest{
+		Coin:      coin,
+		IsBuy:     isBuy,
+		Price:     px,
+		Size:      sz,
+		OrderType: OrderType{Limit: &LimitOrderType{Tif: TifGtc}},
+	}
+}
+
+func reduceOnlyOrder(order CreateOrderRequest) CreateOrderRequest {
+	order.ReduceOnly = true
+	return order
+}
+
+func TestOrderValidator_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		prepare   func(meta *Meta)
+		oiCapped  []string
+		leverages map[string]Leverage
+		order     CreateOrderRequest
+		want      []ValidationError
+	}{
+		{
+			name:  "valid order at min notional",
+			order: limitOrder("ETH", true, 1000, 0.01),
+		},
+		{
+			name:  "unknown coin",
+			order: limitOrder("DOGE", true, 0.2, 100),
+			want:  []ValidationError{{Field: "coin", Message: `unknown coin "DOGE"`}},
+		},
+		{
+			name:  "too many size decimals",
+			order: limitOrder("ETH", true, 1000, 0.12345),
+			want:  []ValidationError{{Field: "size", Message: "0.12345 has 5 decimals, ETH allows 4"}},
+		},
+		{
+			name:  "too many significant figures",
+			order: limitOrder("BTC", true, 100000.5, 0.001),
+			want: []ValidationError{
+				{Field: "price", Message: "100000.5 has 7 significant figures, at most 5 are allowed"},
+			},
+		},
+		{
+			name:  "integer price is always valid",
+			order: limitOrder("BTC", true, 100001, 0.001),
+		},
+		{
+			name:  "too many price decimals",
+			order: limitOrder("ETH", true, 10.123, 1),
+			want:  []ValidationError{{Field: "price", Message: "10.123 has 3 decimals, at most 2 are allowed"}},
+		},
+		{
+			name: "invalid trigger price",
+			order: CreateOrderRequest{
+				Coin:  "ETH",
+				IsBuy: true,
+				Price: 1200,
+				Size:  0.1,
+				OrderType: OrderType{Trigger: &TriggerOrderType{
+					TriggerPx: 1234.56,
+					IsMarket:  true,
+					Tpsl:      "tp",
+				}},
+			},
+			want: []ValidationError{
+				{Field: "triggerPx", Message: "1234.56 has 6 significant figures, at most 5 are allowed"},
+			},
+		},
+		{
+			name:  "below min notional",
+			order: limitOrder("ETH", true, 1000, 0.001),
+			want:  []ValidationError{{Field: "size", Message: "order value 1 is below the minimum of 10"}},
+		},
+		{
+			name:  "reduce only without position",
+			order: reduceOnlyOrder(limitOrder("ETH", false, 1000, 0.001)),
+			want:  []ValidationError{{Field: "reduceOnly", Message: "no open ETH position to reduce"}},
+		},
+		{
+			name:  "reduce only increasing the position",
+			order: reduceOnlyOrder(limitOrder("BTC", true, 100000, 0.1)),
+			want:  []ValidationError{{Field: "reduceOnly", Message: "order would increase the BTC position"}},
+		},
+		{
+			name:  "reduce only closing the position",
+			order: reduceOnlyOrder(limitOrder("BTC", false, 100000, 0.5)),
+		},
+		{
+			name:     "open interest cap",
+			oiCapped: []string{"ETH"},
+			order:    limitOrder("ETH", true, 1000, 0.1),
+			want: []ValidationError{
+				{Field: "coin", Message: "ETH is at its open interest cap, only orders that reduce a position are accepted"},
+			},
+		},
+		{
+			name:     "open interest cap allows reducing",
+			oiCapped: []string{"BTC"},
+			order:    limitOrder("BTC", false, 100000, 0.1),
+		},
+		{
+			name: "delisted",
+			prepare: func(meta *Meta) {
+				meta.Universe[1].IsDelisted = true
+			},
+			order: limitOrder("ETH", true, 1000, 0.1),
+			want:  []ValidationError{{Field: "coin", Message: "ETH is delisted"}},
+		},
+		{
+			name: "only isolated",
+			prepare: func(meta *Meta) {
+				meta.Universe[0].OnlyIsolated = true
+			},
+			order: limitOrder("BTC", true, 100000, 0.1),
+			want:  []ValidationError{{Field: "leverage", Message: "BTC only supports isolated margin"}},
+		},
+		{
+			name:  "leverage above margin tier",
+			order: limitOrder("BTC", true, 100000, 10),
+			want: []ValidationError{
+				{Field: "leverage", Message: "leverage 30x exceeds the max of 20x for a BTC position of 1050000"},
+			},
+		},
+		{
+			name: "leverage above asset max",
+			prepare: func(meta *Meta) {
+				meta.Universe[0].MaxLeverage = 20
+				meta.Universe[0].MarginTableID = 20
+			},
+			order: limitOrder("BTC", true, 100000, 0.1),
+			want: []ValidationError{
+				{Field: "leverage", Message: "leverage 30x exceeds the max of 20x for a BTC position of 60000"},
+			},
+		},
+		{
+			name:      "configured leverage of an opening order",
+			leverages: map[string]Leverage{"ETH": {Type: "cross", Value: 50}},
+			order:     limitOrder("ETH", true, 1000, 1),
+			want: []ValidationError{
+				{Field: "leverage", Message: "leverage 50x exceeds the max of 25x for a ETH position of 1000"},
+			},
+		},
+		{
+			name:      "position leverage wins over the configured one",
+			leverages: map[string]Leverage{"BTC": {Type: "cross", Value: 5}},
+			order:     limitOrder("BTC", true, 100000, 10),
+			want: []ValidationError{
+				{Field: "leverage", Message: "leverage 30x exceeds the max of 20x for a BTC position of 1050000"},
+			},
+		},
+	}
+
+	exchange := newMemoryExchange(t, &memoryTransport{})
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			validator := newTestValidator(tc.prepare)
+			validator.SetOpenInterestCaps(tc.oiCapped)
+			for coin, leverage := range tc.leverages {
+				validator.SetLeverage(coin, leverage)
+			}
+
+			err := validator.Validate(exchange.info, []CreateOrderRequest{tc.order}, GroupingNA)
+			if tc.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var errs ValidationErrors
+			require.True(t, errors.As(err, &errs), "got %v", err)
+			assert.Equal(t, ValidationErrors(tc.want), errs)
+		})
+	}
+}
+
+func TestOrderValidator_MaxLeverage(t *testing.T) {
+	validator := newTestValidator(nil)
+
+	assert.Equal(t, 40, validator.MaxLeverage("BTC", 0))
+	assert.Equal(t, 40, validator.MaxLeverage("BTC", 999999))
+	assert.Equal(t, 20, validator.MaxLeverage("BTC", 1000000))
+	assert.Equal(t, 25, validator.MaxLeverage("ETH", 5000000))
+	assert.Equal(t, 0, validator.MaxLeverage("DOGE", 0))
+}
+
+func TestOrderValidator_Refresh(t *testing.T) {
+	transport := &memoryTransport{
+		infoResponse: func(req map[string]any) ([]byte, error) {
+			switch req["type"] {
+			case "meta":
+				return []byte(`{"universe":[{"name":"BTC","szDecimals":5,"maxLeverage":40,"marginTableId":56},` +
+					`{"name":"ETH","szDecimals":4,"maxLeverage":25,"marginTableId":25,"isDelisted":true}],` +
+					`"marginTables":[[56,{"description":"tiered 40x","marginTiers":[` +
+					`{"lowerBound":"0.0","maxLeverage":40},{"lowerBound":"1000000.0","maxLeverage":20}]}]]}`), nil
+			case "clearinghouseState":
+				return []byte(`{"assetPositions":[{"type":"oneWay","position":{"coin":"BTC","szi":"-0.2",` +
+					`"leverage":{"type":"cross","value":10}}}]}`), nil
+			case "perpsAtOpenInterestCap":
+				return []byte(`["BTC"]`), nil
+			case "activeAssetData":
+				return []byte(`{"coin":"ETH","leverage":{"type":"isolated","value":30}}`), nil
+			}
+			t.Fatalf("unexpected info request %v", req)
+			return nil, nil
+		},
+	}
+	exchange := newMemoryExchange(t, transport)
+
+	validator := NewOrderValidator()
+	require.NoError(t, validator.Refresh(exchange.info, exchange.accountAddr))
+	require.NoError(t, validator.RefreshLeverage(exchange.info, exchange.accountAddr, "ETH"))
+	assert.Equal(t, 20, validator.MaxLeverage("BTC", 2000000))
+
+	err := validator.Validate(exchange.info, []CreateOrderRequest{
+		reduceOnlyOrder(limitOrder("BTC", true, 100000, 0.2)),
+		limitOrder("BTC", false, 100000, 0.1),
+		limitOrder("ETH", true, 1000, 0.1),
+	}, GroupingNA)
+
+	var errs ValidationErrors
+	require.True(t, errors.As(err, &errs), "got %v", err)
+	assert.Empty(t, errs.ForOrder(0))
+	assert.Equal(t, []ValidationError{{
+		Order:   1,
+		Field:   "coin",
+		Message: "BTC is at its open interest cap, only orders that reduce a position are accepted",
+	}}, errs.ForOrder(1))
+	assert.Equal(t, []ValidationError{
+		{Order: 2, Field: "coin", Message: "ETH is delisted"},
+		{Order: 2, Field: "leverage", Message: "leverage 30x exceeds the max of 25x for a ETH position of 100"},
+	}, errs.ForOrder(2))
+}
+
+func TestExchange_Validator(t *testing.T) {
+	transport := &memoryTransport{
+		exchangeResponse: func(map[string]any) ([]byte, error) {
+			return []byte(`{"status":"ok","response":{"type":"order","data":{"statuses":[{"resting":{"oid":1}}]}}}`), nil
+		},
+	}
+	exchange := newMemoryExchange(t, transport)
+	exchange.SetValidator(newTestValidator(nil))
+
+	_, err := exchange.BulkOrders([]CreateOrderRequest{
+		limitOrder("ETH", true, 1000, 0.1),
+		limitOrder("ETH", true, 1000, 0.001),
+	}, nil)
+	var errs ValidationErrors
+	require.True(t, errors.As(err, &errs), "got %v", err)
+	assert.Empty(t, errs.ForOrder(0))
+	assert.Len(t, errs.ForOrder(1), 1)
+
+	_, err = exchange.BulkModifyOrders([]ModifyOrderRequest{
+		{Oid: OidRef(1), Order: limitOrder("ETH", true, 10.123, 1)},
+	})
+	require.True(t, errors.As(err, &errs), "got %v", err)
+	assert.Equal(t, "price", errs[0].Field)
+
+	assert.Empty(t, transport.exchangePayloads)
+
+	_, err = exchange.ModifyOrder(ModifyOrderRequest{
+		Oid:   OidRef(1),
+		Order: limitOrder("ETH", true, 1000, 0.1),
+	})
+	require.NoError(t, err)
+	assert.Len(t, transport.exchangePayloads, 1)
+}
+
+func TestExchange_ValidatorBracketOrder(t *testing.T) {
+	transport := &memoryTransport{
+		exchangeResponse: func(map[string]any) ([]byte, error) {
+			return []byte(`{"status":"ok","response":{"type":"order","data":{"statuses":` +
+				`[{"resting":{"oid":1}},"waitingForFill","waitingForFill"]}}}`), nil
+		},
+	}
+	exchange := newMemoryExchange(t, transport)
+	exchange.SetValidator(newTestValidator(nil))
+
+	// The legs reduce the ETH position the entry opens
+	_, err := exchange.BracketOrder(BracketOrderRequest{
+		Entry:      limitOrder("ETH", true, 4000, 0.5),
+		TakeProfit: &TpslLeg{TriggerPx: 4400, IsMarket: true},
+		StopLoss:   &TpslLeg{TriggerPx: 3800, IsMarket: true},
+	}, nil)
+	require.NoError(t, err)
+	assert.Len(t, transport.exchangePayloads, 1)
+
+	// Legs on the same side as the entry would increase it
+	err = exchange.validator.Validate(exchange.info, []CreateOrderRequest{
+		limitOrder("ETH", true, 4000, 0.5),
+		reduceOnlyOrder(limitOrder("ETH", true, 4400, 0.5)),
+	}, GroupingNormalTpsl)
+	var errs ValidationErrors
+	require.True(t, errors.As(err, &errs), "got %v", err)
+	assert.Equal(t, ValidationErrors{{
+		Order:   1,
+		Field:   "reduceOnly",
+		Message: "order would increase the ETH position",
+	}}, errs)
+
+	// Without the grouping the legs reduce nothing
+	err = exchange.validator.Validate(exchange.info, []CreateOrderRequest{
+		limitOrder("ETH", true, 4000, 0.5),
+		reduceOnlyOrder(limitOrder("ETH", false, 4400, 0.5)),
+	}, GroupingNA)
+	require.True(t, errors.As(err, &errs), "got %v", err)
+	assert.Equal(t, "no open ETH position to reduce", errs[0].Message)
+}
@@ -18,6 +18,8 @@ import (
 const (
 	// pingInterval is the interval for sending ping messages to keep WebSocket alive
 	pingInterval = 50 * time.Second
+	// defaultPostTimeout is how long a post request waits for its response by default
+	defaultPostTimeout = 10 * time.Second
 )
 
 type Subscription struct {
@@ -37,6 +39,10 @@ type WebsocketClient struct {
 	done                  chan struct{}
 	closeOnce             sync.Once
 	reconnectWait         time.Duration
+	nextPostID            atomic.Int64
+	pendingMu             sync.Mutex
+	pending               map[int64]chan wsPostResult
+	postTimeout           time.Duration
 }
 
 func NewWebsocketClient(baseURL string) *WebsocketClient {
@@ -47,15 +53,22 @@ func NewWebsocketClient(baseURL string) *WebsocketClient {
 	if err != nil {
 		log.Fatalf("invalid URL: %v", err)
 	}
-	parsedURL.Scheme = "wss"
+	// Plain http base URLs, such as test servers, are dialed without TLS
+	if parsedURL.Scheme == "http" {
+		parsedURL.Scheme = "ws"
+	} else {
+		parsedURL.Scheme = "wss"
+	}
 	parsedURL.Path = "/ws"
 	wsURL := parsedURL.String()
 
-	return &WebsocketClient{
+	w := &WebsocketClient{
 		url:           wsURL,
 		done:          make(chan struct{}),
 		reconnectWait: time.Second,
 		subscribers:   make(map[string]*uniqSubscriber),
+		pending:       make(map[int64]chan wsPostResult),
+		postTimeout:   defaultPostTimeout,
 		msgDispatcherRegistry: map[string]msgDispatcher{
 			ChannelPong:         NewPongDispatcher(),
 			ChannelTrades:       NewMsgDispatcher[Trades](ChannelTrades),
@@ -66,8 +79,19 @@ func NewWebsocketClient(baseURL string) *WebsocketClient {
 			ChannelOrderUpdates: NewMsgDispatcher[WsOrders](ChannelOrderUpdates),
 			ChannelWebData2:     NewMsgDispatcher[WebData2](ChannelWebData2),
 			ChannelSubResponse:  NewNoopDispatcher(),
+			ChannelUserFundings: NewMsgDispatcher[UserFundings](ChannelUserFundings),
+			ChannelUserNonFundingLedgerUpdates: NewMsgDispatcher[UserNonFundingLedgerUpdates](
+				ChannelUserNonFundingLedgerUpdates,
+			),
+			ChannelUserTwapSliceFills: NewMsgDispatcher[UserTwapSliceFills](
+				ChannelUserTwapSliceFills,
+			),
+			ChannelUserTwapHistory: NewMsgDispatcher[UserTwapHistory](ChannelUserTwapHistory),
 		},
 	}
+	w.msgDispatcherRegistry[ChannelPost] = newPostDispatcher(w)
+
+	return w
 }
 
 func (w *WebsocketClient) Connect(ctx context.Context) error {
@@ -156,15 +180,22 @@ func (w *WebsocketClient) Close() error {
 
 func (w *WebsocketClient) close() error {
 	close(w.done)
+	w.failPending(errConnectionClosed)
 
 	w.mu.Lock()
-	defer w.mu.Unlock()
-
 	if w.conn != nil {
+		defer w.mu.Unlock()
 		return w.conn.Close()
 	}
 
+	// clear runs the unsubscribe callback, which takes the lock itself
+	subscribers := make([]*uniqSubscriber, 0, len(w.subscribers))
 	for _, subscriber := range w.subscribers {
+		subscribers = append(subscribers, subscriber)
+	}
+	w.mu.Unlock()
+
+	for _, subscriber := range subscribers {
 		subscriber.clear()
 	}
 	return nil
@@ -180,6 +211,7 @@ func (w *WebsocketClient) readPump(ctx context.Context) {
 			w.conn = nil
 		}
 		w.mu.Unlock()
+		w.failPending(errConnectionClosed)
 	}()
 
 	for {
@@ -299,7 +331,7 @@ func (w *WebsocketClient) writeJSON(v any) error {
 	defer w.writeMu.Unlock()
 
 	if w.conn == nil {
-		return fmt.Errorf("connection closed")
+		return errConnectionClosed
 	}
 
 	// debug
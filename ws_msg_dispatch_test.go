@@ -0,0 +1,119 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserChannelsDispatch(t *testing.T) {
+	const user = "0x8e0C473fed9630906779f982Cd0F80Cb7011812D"
+
+	tests := []struct {
+		name       string
+		channel    string
+		payload    subscriptable
+		dispatcher msgDispatcher
+		data       string
+		assert     func(t *testing.T, msg any)
+	}{
+		{
+			name:       "user fundings",
+			channel:    ChannelUserFundings,
+			payload:    remoteUserFundingsSubscriptionPayload{Type: ChannelUserFundings, User: user},
+			dispatcher: NewMsgDispatcher[UserFundings](ChannelUserFundings),
+			data:       `{"isSnapshot":true,"user":"0x8e0c473fed9630906779f982cd0f80cb7011812d","fundings":[{"time":1755857880000,"coin":"ETH","usdc":"-0.0123","szi":"0.0025","fundingRate":"0.0000125","nSamples":24}]}`,
+			assert: func(t *testing.T, msg any) {
+				fundings, ok := msg.(UserFundings)
+				require.True(t, ok)
+				assert.True(t, fundings.IsSnapshot)
+				require.Len(t, fundings.Fundings, 1)
+				assert.Equal(t, int64(1755857880000), fundings.Fundings[0].Time)
+				assert.Equal(t, "ETH", fundings.Fundings[0].Coin)
+				assert.Equal(t, "0.0000125", fundings.Fundings[0].FundingRate)
+				require.NotNil(t, fundings.Fundings[0].NSamples)
+				assert.Equal(t, 24, *fundings.Fundings[0].NSamples)
+			},
+		},
+		{
+			name:    "user non-funding ledger updates",
+			channel: ChannelUserNonFundingLedgerUpdates,
+			payload: remoteUserNonFundingLedgerUpdatesSubscriptionPayload{
+				Type: ChannelUserNonFundingLedgerUpdates,
+				User: user,
+			},
+			dispatcher: NewMsgDispatcher[UserNonFundingLedgerUpdates](ChannelUserNonFundingLedgerUpdates),
+			data:       `{"user":"0x8e0c473fed9630906779f982cd0f80cb7011812d","nonFundingLedgerUpdates":[{"time":1755857880000,"hash":"0xabc","delta":{"type":"deposit","usdc":"100.0"}}]}`,
+			assert: func(t *testing.T, msg any) {
+				updates, ok := msg.(UserNonFundingLedgerUpdates)
+				require.True(t, ok)
+				require.Len(t, updates.NonFundingLedgerUpdates, 1)
+				require.NotNil(t, updates.NonFundingLedgerUpdates[0].Delta.Deposit)
+				assert.Equal(t, "100.0", updates.NonFundingLedgerUpdates[0].Delta.Deposit.Usdc)
+			},
+		},
+		{
+			name:       "user twap slice fills",
+			channel:    ChannelUserTwapSliceFills,
+			payload:    remoteUserTwapSliceFillsSubscriptionPayload{Type: ChannelUserTwapSliceFills, User: user},
+			dispatcher: NewMsgDispatcher[UserTwapSliceFills](ChannelUserTwapSliceFills),
+			data:       `{"user":"0x8e0c473fed9630906779f982cd0f80cb7011812d","twapSliceFills":[{"fill":{"coin":"ETH","px":"4307.4","sz":"0.0025","side":"B","time":1755857898644,"tid":1070455675927460},"twapId":3156}]}`,
+			assert: func(t *testing.T, msg any) {
+				fills, ok := msg.(UserTwapSliceFills)
+				require.True(t, ok)
+				require.Len(t, fills.TwapSliceFills, 1)
+				assert.Equal(t, int64(3156), fills.TwapSliceFills[0].TwapID)
+				assert.Equal(t, "4307.4", fills.TwapSliceFills[0].Fill.Price)
+			},
+		},
+		{
+			name:       "user twap history",
+			channel:    ChannelUserTwapHistory,
+			payload:    remoteUserTwapHistorySubscriptionPayload{Type: ChannelUserTwapHistory, User: user},
+			dispatcher: NewMsgDispatcher[UserTwapHistory](ChannelUserTwapHistory),
+			data:       `{"user":"0x8e0c473fed9630906779f982cd0f80cb7011812d","history":[{"time":1755857898,"state":{"coin":"ETH","user":"0x8e0c473fed9630906779f982cd0f80cb7011812d","side":"B","sz":"1.0","executedSz":"0.25","executedNtl":"1076.85","minutes":30,"reduceOnly":false,"randomize":true,"timestamp":1755857898644},"status":{"status":"activated"}}]}`,
+			assert: func(t *testing.T, msg any) {
+				history, ok := msg.(UserTwapHistory)
+				require.True(t, ok)
+				require.Len(t, history.History, 1)
+				assert.Equal(t, TwapStatusActivated, history.History[0].Status.Status)
+				assert.Equal(t, 30, history.History[0].State.Minutes)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received []any
+			subscriber := newUniqSubscriber(
+				tt.payload.Key(),
+				tt.payload,
+				func(subscriptable) {},
+				func(subscriptable) {},
+			)
+			subscriber.subscribe("sub-1", func(msg any) {
+				received = append(received, msg)
+			})
+
+			other := newUniqSubscriber(
+				key(tt.channel, "0x0000000000000000000000000000000000000000"),
+				mockSubscriptable{},
+				func(subscriptable) {},
+				func(subscriptable) {},
+			)
+			other.subscribe("sub-2", func(msg any) {
+				t.Errorf("unexpected message for another user: %+v", msg)
+			})
+
+			err := tt.dispatcher.Dispatch(
+				[]*uniqSubscriber{subscriber, other},
+				wsMessage{Channel: tt.channel, Data: json.RawMessage(tt.data)},
+			)
+			require.NoError(t, err)
+			require.Len(t, received, 1)
+			tt.assert(t, received[0])
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	postRequestTypeInfo   = "info"
+	postRequestTypeAction = "action"
+	postResponseTypeError = "error"
+)
+
+var errConnectionClosed = errors.New("connection closed")
+
+// notSentError is returned when a post request failed before it was written
+// to the socket, so the server cannot have seen it
+type notSentError struct {
+	err error
+}
+
+func (e notSentError) Error() string { return e.err.Error() }
+
+func (e notSentError) Unwrap() error { return e.err }
+
+// PostError is returned when the server answers a post request with an error
+// response rather than failing to deliver it.
+type PostError struct {
+	ID      int64
+	Message string
+}
+
+func (e PostError) Error() string {
+	return fmt.Sprintf("post request %d failed: %s", e.ID, e.Message)
+}
+
+type wsPostResult struct {
+	response wsPostResponsePayload
+	err      error
+}
+
+func newPostDispatcher(w *WebsocketClient) msgDispatcher {
+	return msgDispatcherFunc[wsPostResponse](func(_ []*uniqSubscriber, msg wsMessage) error {
+		if msg.Channel != ChannelPost {
+			return nil
+		}
+
+		var resp wsPostResponse
+		if err := json.Unmarshal(msg.Data, &resp); err != nil {
+			return fmt.Errorf("failed to unmarshal post response: %w", err)
+		}
+
+		w.pendingMu.Lock()
+		ch, ok := w.pending[resp.ID]
+		delete(w.pending, resp.ID)
+		w.pendingMu.Unlock()
+
+		if !ok {
+			return fmt.Errorf("no pending post request with id %d", resp.ID)
+		}
+
+		// Buffered with capacity 1, never blocks the read pump.
+		ch <- wsPostResult{response: resp.Response}
+		return nil
+	})
+}
+
+// SetPostTimeout sets how long post requests wait for a response when the
+// context has no earlier deadline. A timeout of zero or less adds none: posts
+// then wait as long as their context allows.
+func (w *WebsocketClient) SetPostTimeout(timeout time.Duration) {
+	w.pendingMu.Lock()
+	defer w.pendingMu.Unlock()
+	w.postTimeout = timeout
+}
+
+// PostInfo sends an info request over the socket. The returned bytes are the
+// same as the body of the equivalent POST /info request.
+func (w *WebsocketClient) PostInfo(ctx context.Context, request any) ([]byte, error) {
+	resp, err := w.post(ctx, postRequestTypeInfo, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var payload wsPostInfoPayload
+	if err := json.Unmarshal(resp.Payload, &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal info payload: %w", err)
+	}
+	return payload.Data, nil
+}
+
+// PostAction sends a signed action payload over the socket. The returned bytes
+// are the same as the body of the equivalent POST /exchange request.
+func (w *WebsocketClient) PostAction(ctx context.Context, payload any) ([]byte, error) {
+	resp, err := w.post(ctx, postRequestTypeAction, payload)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Payload, nil
+}
+
+// PostSignedAction builds the /exchange payload for an already signed action
+// and sends it over the socket, unmarshalling the response into result.
+func (w *WebsocketClient) PostSignedAction(
+	ctx context.Context,
+	action any,
+	signature SignatureResult,
+	nonce int64,
+	vaultAddress string,
+	expiresAfter *int64,
+	result any,
+) error {
+	payload := newExchangePayload(action, signature, nonce, vaultAddress, expiresAfter)
+
+	resp, err := w.PostAction(ctx, payload)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(resp, result)
+}
+
+func (w *WebsocketClient) post(
+	ctx context.Context,
+	requestType string,
+	payload any,
+) (wsPostResponsePayload, error) {
+	id := w.nextPostID.Add(1)
+	ch := make(chan wsPostResult, 1)
+
+	w.pendingMu.Lock()
+	w.pending[id] = ch
+	timeout := w.postTimeout
+	w.pendingMu.Unlock()
+
+	defer func() {
+		w.pendingMu.Lock()
+		delete(w.pending, id)
+		w.pendingMu.Unlock()
+	}()
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	err := w.writeJSON(wsPostCommand{
+		Method: "post",
+		ID:     id,
+		Request: wsPostRequest{
+			Type:    requestType,
+			Payload: payload,
+		},
+	})
+	if err != nil {
+		err = fmt.Errorf("failed to send post request %d: %w", id, err)
+		// Without a connection nothing was written
+		if errors.Is(err, errConnectionClosed) {
+			err = notSentError{err: err}
+		}
+		return wsPostResponsePayload{}, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return wsPostResponsePayload{}, fmt.Errorf("post request %d: %w", id, ctx.Err())
+	case res := <-ch:
+		if res.err != nil {
+			return wsPostResponsePayload{}, fmt.Errorf("post request %d: %w", id, res.err)
+		}
+		if res.response.Type == postResponseTypeError {
+			var msg string
+			if err := json.Unmarshal(res.response.Payload, &msg); err != nil {
+				msg = string(res.response.Payload)
+			}
+			return wsPostResponsePayload{}, PostError{ID: id, Message: msg}
+		}
+		return res.response, nil
+	}
+}
+
+// failPending unblocks every in-flight post request with the given error.
+func (w *WebsocketClient) failPending(err error) {
+	w.pendingMu.Lock()
+	defer w.pendingMu.Unlock()
+
+	for id, ch := range w.pending {
+		ch <- wsPostResult{err: err}
+		delete(w.pending, id)
+	}
+}
@@ -0,0 +1,207 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testPostCommand struct {
+	Method  string `json:"method"`
+	ID      int64  `json:"id"`
+	Request struct {
+		Type    string          `json:"type"`
+		Payload json.RawMessage `json:"payload"`
+	} `json:"request"`
+}
+
+// newTestWebsocketClient starts a websocket server that hands every post
+// command to reply and connects a client to it.
+func newTestWebsocketClient(
+	t *testing.T,
+	reply func(conn *websocket.Conn, cmd testPostCommand),
+) *WebsocketClient {
+	t.Helper()
+
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(rw, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			var cmd testPostCommand
+			if err := conn.ReadJSON(&cmd); err != nil {
+				return
+			}
+			if cmd.Method == "post" {
+				reply(conn, cmd)
+			}
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	ws := NewWebsocketClient(srv.URL)
+	ws.url = "ws" + strings.TrimPrefix(srv.URL, "http") + "/ws"
+	require.NoError(t, ws.Connect(context.Background()))
+	t.Cleanup(func() { _ = ws.Close() })
+
+	return ws
+}
+
+func writePostResponse(conn *websocket.Conn, id int64, typ string, payload string) {
+	_ = conn.WriteJSON(map[string]any{
+		"channel": ChannelPost,
+		"data": map[string]any{
+			"id": id,
+			"response": map[string]any{
+				"type":    typ,
+				"payload": json.RawMessage(payload),
+			},
+		},
+	})
+}
+
+func TestWebsocketClient_PostInfo(t *testing.T) {
+	ws := newTestWebsocketClient(t, func(conn *websocket.Conn, cmd testPostCommand) {
+		assert.Equal(t, postRequestTypeInfo, cmd.Request.Type)
+		assert.JSONEq(t, `{"type":"l2Book","coin":"ETH"}`, string(cmd.Request.Payload))
+		writePostResponse(conn, cmd.ID, "info",
+			`{"type":"l2Book","data":{"coin":"ETH","time":1755857898644,"levels":[[{"px":"4300.1","sz":"1.5","n":2}],[]]}}`)
+	})
+
+	resp, err := ws.PostInfo(context.Background(), map[string]any{"type": "l2Book", "coin": "ETH"})
+	require.NoError(t, err)
+
+	var book L2Book
+	require.NoError(t, json.Unmarshal(resp, &book))
+	assert.Equal(t, "ETH", book.Coin)
+	assert.Equal(t, 4300.1, book.Levels[0][0].Px)
+}
+
+func TestWebsocketClient_PostSignedAction(t *testing.T) {
+	ws := newTestWebsocketClient(t, func(conn *websocket.Conn, cmd testPostCommand) {
+		assert.Equal(t, postRequestTypeAction, cmd.Request.Type)
+
+		var payload map[string]any
+		require.NoError(t, json.Unmarshal(cmd.Request.Payload, &payload))
+		assert.EqualValues(t, 1755857898644, payload["nonce"])
+		assert.Contains(t, payload, "signature")
+		assert.Contains(t, payload, "action")
+
+		writePostResponse(conn, cmd.ID, "action",
+			`{"status":"ok","response":{"type":"order","data":{"statuses":[{"resting":{"oid":77738308}}]}}}`)
+	})
+
+	action := CancelAction{Type: "cancel", Cancels: []CancelOrderWire{{Asset: 1, OrderID: 1}}}
+	var result APIResponse[OrderResponse]
+	err := ws.PostSignedAction(
+		context.Background(),
+		action,
+		SignatureResult{R: "0x1", S: "0x2", V: 27},
+		1755857898644,
+		"",
+		nil,
+		&result,
+	)
+	require.NoError(t, err)
+	require.True(t, result.Ok)
+	require.Len(t, result.Data.Statuses, 1)
+	assert.Equal(t, int64(77738308), result.Data.Statuses[0].Resting.Oid)
+}
+
+func TestWebsocketClient_PostErrors(t *testing.T) {
+	t.Run("error response", func(t *testing.T) {
+		ws := newTestWebsocketClient(t, func(conn *websocket.Conn, cmd testPostCommand) {
+			writePostResponse(conn, cmd.ID, "error", `"Invalid request"`)
+		})
+
+		_, err := ws.PostInfo(context.Background(), map[string]any{"type": "nope"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Invalid request")
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		ws := newTestWebsocketClient(t, func(*websocket.Conn, testPostCommand) {})
+		ws.SetPostTimeout(50 * time.Millisecond)
+
+		_, err := ws.PostInfo(context.Background(), map[string]any{"type": "allMids"})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		ws.pendingMu.Lock()
+		defer ws.pendingMu.Unlock()
+		assert.Empty(t, ws.pending)
+	})
+
+	t.Run("no timeout", func(t *testing.T) {
+		ws := newTestWebsocketClient(t, func(conn *websocket.Conn, cmd testPostCommand) {
+			time.Sleep(20 * time.Millisecond)
+			writePostResponse(conn, cmd.ID, "info", `{"type":"allMids","data":{}}`)
+		})
+		ws.SetPostTimeout(0)
+
+		resp, err := ws.PostInfo(context.Background(), map[string]any{"type": "allMids"})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{}`, string(resp))
+
+		// The context still bounds the wait
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+		defer cancel()
+		_, err = ws.PostInfo(ctx, map[string]any{"type": "allMids"})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("disconnect", func(t *testing.T) {
+		ws := newTestWebsocketClient(t, func(conn *websocket.Conn, _ testPostCommand) {
+			_ = conn.Close()
+		})
+
+		// The request was written before the connection dropped
+		_, err := ws.PostInfo(context.Background(), map[string]any{"type": "allMids"})
+		require.ErrorIs(t, err, errConnectionClosed)
+		assert.False(t, errors.As(err, &notSentError{}))
+
+		_, err = ws.PostInfo(context.Background(), map[string]any{"type": "allMids"})
+		require.ErrorIs(t, err, errConnectionClosed)
+		assert.True(t, errors.As(err, &notSentError{}))
+	})
+
+	t.Run("out of order responses", func(t *testing.T) {
+		var first *testPostCommand
+		ws := newTestWebsocketClient(t, func(conn *websocket.Conn, cmd testPostCommand) {
+			if first == nil {
+				first = &cmd
+				return
+			}
+			writePostResponse(conn, cmd.ID, "info", `{"type":"allMids","data":{"id":"second"}}`)
+			writePostResponse(conn, first.ID, "info", `{"type":"allMids","data":{"id":"first"}}`)
+		})
+
+		results := make(chan string, 2)
+		for range 2 {
+			go func() {
+				resp, err := ws.PostInfo(context.Background(), map[string]any{"type": "allMids"})
+				if err != nil {
+					results <- err.Error()
+					return
+				}
+				results <- string(resp)
+			}()
+			time.Sleep(20 * time.Millisecond)
+		}
+
+		got := []string{<-results, <-results}
+		assert.ElementsMatch(t, []string{`{"id":"first"}`, `{"id":"second"}`}, got)
+	})
+}
@@ -0,0 +1,236 @@
+package hyperliquid
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"sync"
+)
+
+const (
+	// defaultStreamBufferSize is the channel capacity used when StreamOptions.BufferSize is not set
+	defaultStreamBufferSize = 64
+)
+
+// ErrSlowConsumer is returned when a stream using SlowConsumerDisconnect is
+// closed because its buffer was full.
+var ErrSlowConsumer = errors.New("slow consumer: stream buffer full")
+
+// SlowConsumerPolicy decides what happens to a message when the stream buffer is full.
+type SlowConsumerPolicy int
+
+const (
+	// SlowConsumerBlock waits until the consumer makes room. This stalls the
+	// websocket read loop, like a slow callback would.
+	SlowConsumerBlock SlowConsumerPolicy = iota
+	// SlowConsumerDropOldest discards the oldest buffered message.
+	SlowConsumerDropOldest
+	// SlowConsumerDropNewest discards the incoming message.
+	SlowConsumerDropNewest
+	// SlowConsumerDisconnect closes the stream with ErrSlowConsumer.
+	SlowConsumerDisconnect
+)
+
+type StreamOptions struct {
+	// BufferSize is the channel capacity. Defaults to 64.
+	BufferSize int
+	// Policy applies when the buffer is full. Defaults to SlowConsumerBlock.
+	Policy SlowConsumerPolicy
+	// OnError receives the errors of messages that SubscribeChan leaves out
+	// of the channel, e.g. ones that failed to decode. It runs on the
+	// websocket read loop. Nil drops them. SubscribeIter yields them instead.
+	OnError func(error)
+}
+
+// SubscribeFunc matches the callback based subscription methods of
+// WebsocketClient, e.g. ws.Trades or ws.L2Book.
+type SubscribeFunc[P, T any] func(params P, callback func(T, error)) (*Subscription, error)
+
+// SubscribeChan adapts a callback based subscription into a channel. The
+// channel is closed when the returned subscription is closed, when ctx is done
+// or, with SlowConsumerDisconnect, when the consumer falls behind. Message
+// errors are passed to opts.OnError.
+//
+//	trades, sub, err := hyperliquid.SubscribeChan(ctx, ws.Trades, hyperliquid.TradesSubscriptionParams{Coin: "BTC"}, opts)
+func SubscribeChan[P, T any](
+	ctx context.Context,
+	subscribe SubscribeFunc[P, T],
+	params P,
+	opts StreamOptions,
+) (<-chan T, *Subscription, error) {
+	s, sub, err := subscribeStream(ctx, subscribe, params, opts, func(v T, err error) (T, bool) {
+		if err != nil {
+			if opts.OnError != nil {
+				opts.OnError(err)
+			}
+			return v, false
+		}
+		return v, true
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.ch, sub, nil
+}
+
+type streamItem[T any] struct {
+	value T
+	err   error
+}
+
+// SubscribeIter adapts a callback based subscription into an iterator. The
+// subscription starts when iteration begins and ends when the loop exits or
+// ctx is done. Message errors are yielded without ending the iteration; the
+// reason the stream ended (ctx.Err(), ErrSlowConsumer) is yielded last.
+func SubscribeIter[P, T any](
+	ctx context.Context,
+	subscribe SubscribeFunc[P, T],
+	params P,
+	opts StreamOptions,
+) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		s, sub, err := subscribeStream(
+			ctx,
+			subscribe,
+			params,
+			opts,
+			func(v T, err error) (streamItem[T], bool) {
+				return streamItem[T]{value: v, err: err}, true
+			},
+		)
+		if err != nil {
+			var zero T
+			yield(zero, err)
+			return
+		}
+		defer sub.Close()
+
+		for item := range s.ch {
+			if !yield(item.value, item.err) {
+				return
+			}
+		}
+
+		if s.err != nil {
+			var zero T
+			yield(zero, s.err)
+		}
+	}
+}
+
+func subscribeStream[P, T, E any](
+	ctx context.Context,
+	subscribe SubscribeFunc[P, T],
+	params P,
+	opts StreamOptions,
+	wrap func(T, error) (E, bool),
+) (*stream[E], *Subscription, error) {
+	if opts.BufferSize <= 0 {
+		opts.BufferSize = defaultStreamBufferSize
+	}
+
+	s := &stream[E]{
+		ch:     make(chan E, opts.BufferSize),
+		done:   make(chan struct{}),
+		policy: opts.Policy,
+	}
+
+	sub, err := subscribe(params, func(v T, err error) {
+		if item, ok := wrap(v, err); ok {
+			s.push(item)
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Unsubscribe from a separate goroutine: the stream may be closed from
+	// within a callback, which runs under the subscriber lock.
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.close(ctx.Err())
+		case <-s.done:
+		}
+		sub.Close()
+	}()
+
+	return s, &Subscription{
+		ID:      sub.ID,
+		Payload: sub.Payload,
+		Close: func() {
+			s.close(nil)
+			sub.Close()
+		},
+	}, nil
+}
+
+// stream is a buffered channel guarded against sends after close.
+type stream[E any] struct {
+	ch       chan E
+	done     chan struct{}
+	doneOnce sync.Once
+	mu       sync.Mutex
+	closed   bool
+	err      error
+	policy   SlowConsumerPolicy
+}
+
+func (s *stream[E]) push(item E) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	switch s.policy {
+	case SlowConsumerDropOldest:
+		for {
+			select {
+			case s.ch <- item:
+				return
+			default:
+			}
+			select {
+			case <-s.ch:
+			default:
+			}
+		}
+	case SlowConsumerDropNewest:
+		select {
+		case s.ch <- item:
+		default:
+		}
+	case SlowConsumerDisconnect:
+		select {
+		case s.ch <- item:
+		default:
+			s.closeLocked(ErrSlowConsumer)
+		}
+	default:
+		select {
+		case s.ch <- item:
+		case <-s.done:
+		}
+	}
+}
+
+func (s *stream[E]) close(err error) {
+	// Signal done before taking the lock so a blocked push can return.
+	s.doneOnce.Do(func() { close(s.done) })
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closeLocked(err)
+}
+
+func (s *stream[E]) closeLocked(err error) {
+	if s.closed {
+		return
+	}
+	s.doneOnce.Do(func() { close(s.done) })
+	s.closed = true
+	s.err = err
+	close(s.ch)
+}
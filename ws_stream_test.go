@@ -0,0 +1,215 @@
+package hyperliquid
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeFeed mimics a WebsocketClient subscription method and lets tests push messages.
+type fakeFeed struct {
+	mu       sync.Mutex
+	callback func(int, error)
+	closed   chan struct{}
+}
+
+func newFakeFeed() *fakeFeed {
+	return &fakeFeed{closed: make(chan struct{})}
+}
+
+func (f *fakeFeed) Subscribe(_ struct{}, callback func(int, error)) (*Subscription, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.callback = callback
+	var once sync.Once
+	return &Subscription{
+		ID:    "fake",
+		Close: func() { once.Do(func() { close(f.closed) }) },
+	}, nil
+}
+
+func (f *fakeFeed) send(v int, err error) {
+	f.mu.Lock()
+	cb := f.callback
+	f.mu.Unlock()
+	cb(v, err)
+}
+
+func (f *fakeFeed) waitClosed(t *testing.T) {
+	t.Helper()
+	select {
+	case <-f.closed:
+	case <-time.After(time.Second):
+		t.Fatal("subscription was not closed")
+	}
+}
+
+func drain(ch <-chan int) []int {
+	var out []int
+	for v := range ch {
+		out = append(out, v)
+	}
+	return out
+}
+
+func TestSubscribeChan_Policies(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy SlowConsumerPolicy
+		want   []int
+	}{
+		{name: "drop oldest", policy: SlowConsumerDropOldest, want: []int{3, 4}},
+		{name: "drop newest", policy: SlowConsumerDropNewest, want: []int{1, 2}},
+		{name: "disconnect", policy: SlowConsumerDisconnect, want: []int{1, 2}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			feed := newFakeFeed()
+			ch, sub, err := SubscribeChan(
+				context.Background(),
+				feed.Subscribe,
+				struct{}{},
+				StreamOptions{BufferSize: 2, Policy: tc.policy},
+			)
+			require.NoError(t, err)
+
+			for i := 1; i <= 4; i++ {
+				feed.send(i, nil)
+			}
+			if tc.policy != SlowConsumerDisconnect {
+				sub.Close()
+			}
+
+			assert.Equal(t, tc.want, drain(ch))
+			feed.waitClosed(t)
+		})
+	}
+}
+
+func TestSubscribeChan_Block(t *testing.T) {
+	feed := newFakeFeed()
+	ch, sub, err := SubscribeChan(
+		context.Background(),
+		feed.Subscribe,
+		struct{}{},
+		StreamOptions{BufferSize: 1, Policy: SlowConsumerBlock},
+	)
+	require.NoError(t, err)
+
+	feed.send(1, nil)
+	sent := make(chan struct{})
+	go func() {
+		feed.send(2, nil)
+		close(sent)
+	}()
+
+	select {
+	case <-sent:
+		t.Fatal("send should block while the buffer is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	assert.Equal(t, 1, <-ch)
+	<-sent
+	assert.Equal(t, 2, <-ch)
+
+	// Closing releases a producer blocked on a full buffer.
+	feed.send(3, nil)
+	go feed.send(4, nil)
+	sub.Close()
+	assert.Equal(t, []int{3}, drain(ch))
+}
+
+func TestSubscribeChan_ContextCancel(t *testing.T) {
+	feed := newFakeFeed()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var errs []error
+	ch, _, err := SubscribeChan(ctx, feed.Subscribe, struct{}{}, StreamOptions{
+		OnError: func(err error) { errs = append(errs, err) },
+	})
+	require.NoError(t, err)
+
+	feed.send(1, nil)
+	feed.send(0, errors.New("bad message"))
+	cancel()
+
+	assert.Equal(t, []int{1}, drain(ch))
+	assert.Equal(t, []error{errors.New("bad message")}, errs)
+	feed.waitClosed(t)
+
+	// Messages after close are ignored.
+	feed.send(2, nil)
+}
+
+func TestSubscribeIter(t *testing.T) {
+	t.Run("yields values and errors", func(t *testing.T) {
+		feed := newFakeFeed()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		ready := make(chan struct{})
+		go func() {
+			<-ready
+			feed.send(1, nil)
+			feed.send(0, errors.New("bad message"))
+			feed.send(2, nil)
+		}()
+
+		var (
+			values []int
+			errs   []string
+		)
+		for v, err := range SubscribeIter(ctx, func(p struct{}, cb func(int, error)) (*Subscription, error) {
+			defer close(ready)
+			return feed.Subscribe(p, cb)
+		}, struct{}{}, StreamOptions{}) {
+			if err != nil {
+				errs = append(errs, err.Error())
+				continue
+			}
+			values = append(values, v)
+			if v == 2 {
+				break
+			}
+		}
+
+		assert.Equal(t, []int{1, 2}, values)
+		assert.Equal(t, []string{"bad message"}, errs)
+		feed.waitClosed(t)
+	})
+
+	t.Run("reports disconnect", func(t *testing.T) {
+		feed := newFakeFeed()
+		var last error
+		for _, err := range SubscribeIter(context.Background(), func(p struct{}, cb func(int, error)) (*Subscription, error) {
+			sub, err := feed.Subscribe(p, cb)
+			for i := range 3 {
+				cb(i, nil)
+			}
+			return sub, err
+		}, struct{}{}, StreamOptions{BufferSize: 1, Policy: SlowConsumerDisconnect}) {
+			last = err
+		}
+
+		assert.ErrorIs(t, last, ErrSlowConsumer)
+		feed.waitClosed(t)
+	})
+
+	t.Run("subscribe error", func(t *testing.T) {
+		want := errors.New("not connected")
+		var got []error
+		for _, err := range SubscribeIter(context.Background(), func(struct{}, func(int, error)) (*Subscription, error) {
+			return nil, want
+		}, struct{}{}, StreamOptions{}) {
+			got = append(got, err)
+		}
+		assert.Equal(t, []error{want}, got)
+	})
+}
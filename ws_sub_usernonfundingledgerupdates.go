@@ -0,0 +1,27 @@
+package hyperliquid
+
+import "fmt"
+
+type UserNonFundingLedgerUpdatesSubscriptionParams struct {
+	User string
+}
+
+func (w *WebsocketClient) UserNonFundingLedgerUpdates(
+	params UserNonFundingLedgerUpdatesSubscriptionParams,
+	callback func(UserNonFundingLedgerUpdates, error),
+) (*Subscription, error) {
+	payload := remoteUserNonFundingLedgerUpdatesSubscriptionPayload{
+		Type: ChannelUserNonFundingLedgerUpdates,
+		User: params.User,
+	}
+
+	return w.subscribe(payload, func(msg any) {
+		userNonFundingLedgerUpdates, ok := msg.(UserNonFundingLedgerUpdates)
+		if !ok {
+			callback(UserNonFundingLedgerUpdates{}, fmt.Errorf("invalid message type"))
+			return
+		}
+
+		callback(userNonFundingLedgerUpdates, nil)
+	})
+}
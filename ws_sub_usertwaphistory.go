@@ -0,0 +1,27 @@
+package hyperliquid
+
+import "fmt"
+
+type UserTwapHistorySubscriptionParams struct {
+	User string
+}
+
+func (w *WebsocketClient) UserTwapHistory(
+	params UserTwapHistorySubscriptionParams,
+	callback func(UserTwapHistory, error),
+) (*Subscription, error) {
+	payload := remoteUserTwapHistorySubscriptionPayload{
+		Type: ChannelUserTwapHistory,
+		User: params.User,
+	}
+
+	return w.subscribe(payload, func(msg any) {
+		userTwapHistory, ok := msg.(UserTwapHistory)
+		if !ok {
+			callback(UserTwapHistory{}, fmt.Errorf("invalid message type"))
+			return
+		}
+
+		callback(userTwapHistory, nil)
+	})
+}
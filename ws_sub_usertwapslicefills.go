@@ -0,0 +1,27 @@
+package hyperliquid
+
+import "fmt"
+
+type UserTwapSliceFillsSubscriptionParams struct {
+	User string
+}
+
+func (w *WebsocketClient) UserTwapSliceFills(
+	params UserTwapSliceFillsSubscriptionParams,
+	callback func(UserTwapSliceFills, error),
+) (*Subscription, error) {
+	payload := remoteUserTwapSliceFillsSubscriptionPayload{
+		Type: ChannelUserTwapSliceFills,
+		User: params.User,
+	}
+
+	return w.subscribe(payload, func(msg any) {
+		userTwapSliceFills, ok := msg.(UserTwapSliceFills)
+		if !ok {
+			callback(UserTwapSliceFills{}, fmt.Errorf("invalid message type"))
+			return
+		}
+
+		callback(userTwapSliceFills, nil)
+	})
+}
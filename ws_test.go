@@ -0,0 +1,65 @@
+package hyperliquid
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWebsocketClient_URL(t *testing.T) {
+	tests := []struct {
+		baseURL string
+		want    string
+	}{
+		{baseURL: "", want: "wss://api.hyperliquid.xyz/ws"},
+		{baseURL: TestnetAPIURL, want: "wss://api.hyperliquid-testnet.xyz/ws"},
+		{baseURL: "http://127.0.0.1:8080", want: "ws://127.0.0.1:8080/ws"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.baseURL, func(t *testing.T) {
+			assert.Equal(t, tc.want, NewWebsocketClient(tc.baseURL).url)
+		})
+	}
+}
+
+func TestWebsocketClient_CloseAfterDisconnect(t *testing.T) {
+	// The server drops the connection on the first command it reads
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(rw, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _, _ = conn.ReadMessage()
+	}))
+	t.Cleanup(srv.Close)
+
+	ws := NewWebsocketClient(srv.URL)
+	require.NoError(t, ws.Connect(context.Background()))
+	_, err := ws.AllMids(AllMidsSubscriptionParams{}, func(AllMids, error) {})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		ws.mu.RLock()
+		defer ws.mu.RUnlock()
+		return ws.conn == nil
+	}, time.Second, 10*time.Millisecond)
+
+	// Clearing the subscribers unsubscribes them, which takes the lock again
+	closed := make(chan error, 1)
+	go func() { closed <- ws.Close() }()
+	select {
+	case err := <-closed:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("Close deadlocked")
+	}
+}
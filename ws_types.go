@@ -16,6 +16,12 @@ const (
 	ChannelOrderUpdates string = "orderUpdates"
 	ChannelWebData2     string = "webData2"
 	ChannelSubResponse  string = "subscriptionResponse"
+	ChannelPost         string = "post"
+
+	ChannelUserFundings                string = "userFundings"
+	ChannelUserNonFundingLedgerUpdates string = "userNonFundingLedgerUpdates"
+	ChannelUserTwapSliceFills          string = "userTwapSliceFills"
+	ChannelUserTwapHistory             string = "userTwapHistory"
 )
 
 type wsMessage struct {
@@ -28,6 +34,32 @@ type wsCommand struct {
 	Subscription any    `json:"subscription,omitempty"`
 }
 
+type wsPostCommand struct {
+	Method  string        `json:"method"`
+	ID      int64         `json:"id"`
+	Request wsPostRequest `json:"request"`
+}
+
+type wsPostRequest struct {
+	Type    string `json:"type"`
+	Payload any    `json:"payload"`
+}
+
+type wsPostResponse struct {
+	ID       int64                 `json:"id"`
+	Response wsPostResponsePayload `json:"response"`
+}
+
+type wsPostResponsePayload struct {
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+type wsPostInfoPayload struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
 type (
 	Trade struct {
 		Coin  string   `json:"coin"`
@@ -50,22 +82,23 @@ type (
 
 	//easyjson:skip
 	WebData2 struct {
-		ClearinghouseState     *ClearinghouseState `json:"clearinghouseState,omitempty"`
-		LeadingVaults          []any               `json:"leadingVaults,omitempty"`
-		TotalVaultEquity       string              `json:"totalVaultEquity,omitempty"`
-		OpenOrders             []WsBasicOrder      `json:"openOrders,omitempty"`
-		AgentAddress           *string             `json:"agentAddress,omitempty"`
-		AgentValidUntil        *int64              `json:"agentValidUntil,omitempty"`
-		CumLedger              string              `json:"cumLedger,omitempty"`
-		Meta                   *WebData2Meta       `json:"meta,omitempty"`
-		AssetCtxs              []AssetCtx          `json:"assetCtxs,omitempty"`
-		ServerTime             int64               `json:"serverTime,omitempty"`
-		IsVault                bool                `json:"isVault,omitempty"`
-		User                   string              `json:"user,omitempty"`
-		TwapStates             []any               `json:"twapStates,omitempty"`
-		SpotState              *SpotState          `json:"spotState,omitempty"`
-		SpotAssetCtxs          []SpotAssetCtx      `json:"spotAssetCtxs,omitempty"`
-		PerpsAtOpenInterestCap []string            `json:"perpsAtOpenInterestCap,omitempty"`
+		ClearinghouseState *ClearinghouseState `json:"clearinghouseState,omitempty"`
+		LeadingVaults      []LeadingVault      `json:"leadingVaults,omitempty"`
+		TotalVaultEquity   string              `json:"totalVaultEquity,omitempty"`
+		OpenOrders         []WsBasicOrder      `json:"openOrders,omitempty"`
+		AgentAddress       *string             `json:"agentAddress,omitempty"`
+		AgentValidUntil    *int64              `json:"agentValidUntil,omitempty"`
+		CumLedger          string              `json:"cumLedger,omitempty"`
+		Meta               *WebData2Meta       `json:"meta,omitempty"`
+		AssetCtxs          []AssetCtx          `json:"assetCtxs,omitempty"`
+		ServerTime         int64               `json:"serverTime,omitempty"`
+		IsVault            bool                `json:"isVault,omitempty"`
+		User               string              `json:"user,omitempty"`
+		// TwapStates pairs each running TWAP id with its state
+		TwapStates             []Tuple2[int64, TwapState] `json:"twapStates,omitempty"`
+		SpotState              *SpotState                 `json:"spotState,omitempty"`
+		SpotAssetCtxs          []SpotAssetCtx             `json:"spotAssetCtxs,omitempty"`
+		PerpsAtOpenInterestCap []string                   `json:"perpsAtOpenInterestCap,omitempty"`
 	}
 
 	//easyjson:skip
@@ -135,6 +168,37 @@ type (
 		Sz float64 `json:"sz,string"`
 	}
 
+	UserFundings struct {
+		IsSnapshot bool          `json:"isSnapshot,omitempty"`
+		User       string        `json:"user"`
+		Fundings   []UserFunding `json:"fundings"`
+	}
+
+	// UserFunding is a funding payment pushed on the userFundings channel. It
+	// carries the fields of the REST UserFundingDelta next to its time.
+	UserFunding struct {
+		Time int64 `json:"time"`
+		UserFundingDelta
+	}
+
+	UserNonFundingLedgerUpdates struct {
+		IsSnapshot              bool           `json:"isSnapshot,omitempty"`
+		User                    string         `json:"user"`
+		NonFundingLedgerUpdates []LedgerUpdate `json:"nonFundingLedgerUpdates"`
+	}
+
+	UserTwapSliceFills struct {
+		IsSnapshot     bool            `json:"isSnapshot,omitempty"`
+		User           string          `json:"user"`
+		TwapSliceFills []TwapSliceFill `json:"twapSliceFills"`
+	}
+
+	UserTwapHistory struct {
+		IsSnapshot bool          `json:"isSnapshot,omitempty"`
+		User       string        `json:"user"`
+		History    []TwapHistory `json:"history"`
+	}
+
 	Candle struct {
 		Timestamp int64  `json:"T"`
 		Close     string `json:"c"`
@@ -148,3 +212,13 @@ type (
 		Volume    string `json:"v"`
 	}
 )
+
+// TwapState returns the state of the running TWAP with the given id
+func (w WebData2) TwapState(twapID int64) (TwapState, bool) {
+	for _, entry := range w.TwapStates {
+		if entry.First == twapID {
+			return entry.Second, true
+		}
+	}
+	return TwapState{}, false
+}
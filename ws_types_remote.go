@@ -98,3 +98,55 @@ func (p remoteWebData2SubscriptionPayload) Channel() string {
 func (p remoteWebData2SubscriptionPayload) Key() string {
 	return keyWebData2(p.User)
 }
+
+type remoteUserFundingsSubscriptionPayload struct {
+	Type string `json:"type"`
+	User string `json:"user"`
+}
+
+func (p remoteUserFundingsSubscriptionPayload) Channel() string {
+	return p.Type
+}
+
+func (p remoteUserFundingsSubscriptionPayload) Key() string {
+	return keyUserFundings(p.User)
+}
+
+type remoteUserNonFundingLedgerUpdatesSubscriptionPayload struct {
+	Type string `json:"type"`
+	User string `json:"user"`
+}
+
+func (p remoteUserNonFundingLedgerUpdatesSubscriptionPayload) Channel() string {
+	return p.Type
+}
+
+func (p remoteUserNonFundingLedgerUpdatesSubscriptionPayload) Key() string {
+	return keyUserNonFundingLedgerUpdates(p.User)
+}
+
+type remoteUserTwapSliceFillsSubscriptionPayload struct {
+	Type string `json:"type"`
+	User string `json:"user"`
+}
+
+func (p remoteUserTwapSliceFillsSubscriptionPayload) Channel() string {
+	return p.Type
+}
+
+func (p remoteUserTwapSliceFillsSubscriptionPayload) Key() string {
+	return keyUserTwapSliceFills(p.User)
+}
+
+type remoteUserTwapHistorySubscriptionPayload struct {
+	Type string `json:"type"`
+	User string `json:"user"`
+}
+
+func (p remoteUserTwapHistorySubscriptionPayload) Channel() string {
+	return p.Type
+}
+
+func (p remoteUserTwapHistorySubscriptionPayload) Key() string {
+	return keyUserTwapHistory(p.User)
+}
@@ -51,3 +51,19 @@ func (w WebData2) Key() string {
 	// The dispatching is handled by the subscription system based on the subscription key.
 	return ChannelWebData2
 }
+
+func (u UserFundings) Key() string {
+	return keyUserFundings(u.User)
+}
+
+func (u UserNonFundingLedgerUpdates) Key() string {
+	return keyUserNonFundingLedgerUpdates(u.User)
+}
+
+func (u UserTwapSliceFills) Key() string {
+	return keyUserTwapSliceFills(u.User)
+}
+
+func (u UserTwapHistory) Key() string {
+	return keyUserTwapHistory(u.User)
+}
@@ -50,3 +50,22 @@ func keyWebData2(_ string) string {
 	// The dispatching is handled by the subscription system based on the subscription key.
 	return key(ChannelWebData2)
 }
+
+// The user channels below carry the user in every message, so unlike orderUpdates or webData2 the
+// key can include it. Addresses are lowercased as the server echoes them back in lowercase.
+
+func keyUserFundings(user string) string {
+	return key(ChannelUserFundings, strings.ToLower(user))
+}
+
+func keyUserNonFundingLedgerUpdates(user string) string {
+	return key(ChannelUserNonFundingLedgerUpdates, strings.ToLower(user))
+}
+
+func keyUserTwapSliceFills(user string) string {
+	return key(ChannelUserTwapSliceFills, strings.ToLower(user))
+}
+
+func keyUserTwapHistory(user string) string {
+	return key(ChannelUserTwapHistory, strings.ToLower(user))
+}